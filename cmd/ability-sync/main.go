@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"os"
 	"time"
@@ -11,19 +12,37 @@ import (
 
 	"pokedex/internal/ability/repository"
 	"pokedex/internal/ability/service"
+	"pokedex/internal/shared/checkpoint"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+	"pokedex/internal/shared/tombstone"
 )
 
 func main() {
 	log.Println("Starting Ability Sync Job...")
 
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
 	// Load configuration
 	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
 
 	// Connect to MongoDB
 	database.ConnectDB(cfg)
 	defer database.DisconnectDB()
 
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Store which documents each full run tombstoned or saw renamed upstream
+	opts.Reports = tombstone.NewMongoReportRepository()
+
 	// Initialize shared PokeAPI client
 	pokeAPIClient := pokeapi.NewClient(cfg)
 	defer pokeAPIClient.CloseClient()
@@ -36,12 +55,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
 	defer cancel()
 
-	err := abilityService.SyncAllAbilities(ctx)
+	stats, err := abilityService.SyncAllAbilities(ctx, *opts)
 	if err != nil {
 		log.Fatalf("Ability data sync failed: %v", err)
 		os.Exit(1) // Keluar dengan status error
 	}
 
-	log.Println("Ability data sync completed successfully.")
+	log.Printf("Ability data sync completed successfully. %s\n", stats)
 	os.Exit(0) // Keluar dengan status sukses
 }
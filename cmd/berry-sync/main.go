@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+
+	"pokedex/internal/berry/repository"
+	"pokedex/internal/berry/service"
+	"pokedex/internal/shared/checkpoint"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+	"pokedex/internal/shared/tombstone"
+)
+
+func main() {
+	log.Println("Starting Berry Sync Job...")
+
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Store which documents each full run tombstoned or saw renamed upstream
+	opts.Reports = tombstone.NewMongoReportRepository()
+
+	// Initialize shared PokeAPI client
+	pokeAPIClient := pokeapi.NewClient(cfg)
+	defer pokeAPIClient.CloseClient()
+
+	// Initialize Berry Module
+	berryRepo := repository.NewMongoBerryRepository()
+	berryService := service.NewBerryService(berryRepo, pokeAPIClient)
+
+	// Berries and berry flavors reference each other only by name
+	stages := []syncer.Stage{
+		{Name: "berry", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return berryService.SyncAllBerries(ctx, *opts)
+		}},
+		{Name: "berry-flavor", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return berryService.SyncAllBerryFlavors(ctx, *opts)
+		}},
+	}
+
+	// Run the synchronization
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	results, err := syncer.RunStages(ctx, stages)
+	if err != nil {
+		log.Fatalf("Berry data sync failed: %v", err)
+	}
+
+	syncer.PrintSummary(os.Stdout, results)
+
+	for _, res := range results {
+		if res.Err != nil || res.Skipped {
+			log.Println("Berry data sync finished with failed stages.")
+			os.Exit(1) // Keluar dengan status error
+		}
+	}
+
+	log.Println("Berry data sync completed successfully.")
+	os.Exit(0) // Keluar dengan status sukses
+}
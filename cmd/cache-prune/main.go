@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"pokedex/config"
+	"pokedex/internal/shared/pokeapi"
+)
+
+func main() {
+	log.Println("Starting PokeAPI Cache Prune Job...")
+
+	all := flag.Bool("all", false, "remove every cached response")
+	maxMB := flag.Int("max-mb", -1, "prune down to this many MB (default POKEAPI_CACHE_MAX_MB)")
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+
+	if cfg.PokeAPICacheDir == "" {
+		log.Println("POKEAPI_CACHE_DIR is empty, the response cache is disabled. Nothing to prune.")
+		os.Exit(0)
+	}
+
+	limitMB := cfg.PokeAPICacheMaxMB
+	if *maxMB >= 0 {
+		limitMB = *maxMB
+	}
+	if *all {
+		limitMB = 0
+	}
+
+	result, err := pokeapi.PruneCache(cfg.PokeAPICacheDir, int64(limitMB)*1024*1024)
+	if err != nil {
+		log.Fatalf("PokeAPI cache prune failed: %v", err)
+		os.Exit(1) // Keluar dengan status error
+	}
+
+	log.Printf("PokeAPI cache pruned: removed %d entries (%d bytes), kept %d entries (%d bytes).\n",
+		result.EntriesRemoved, result.BytesRemoved, result.EntriesKept, result.BytesKept)
+	os.Exit(0) // Keluar dengan status sukses
+}
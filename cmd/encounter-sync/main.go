@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+
+	"pokedex/internal/encounter/repository"
+	"pokedex/internal/encounter/service"
+	"pokedex/internal/shared/checkpoint"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+)
+
+func main() {
+	log.Println("Starting Encounter Sync Job...")
+
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Initialize shared PokeAPI client
+	pokeAPIClient := pokeapi.NewClient(cfg)
+	defer pokeAPIClient.CloseClient()
+
+	// Initialize Encounter Module
+	encounterRepo := repository.NewMongoEncounterRepository()
+	encounterService := service.NewEncounterService(encounterRepo, pokeAPIClient)
+
+	// Encounters, location data and encounter methods/conditions are independent of each other
+	stages := []syncer.Stage{
+		{Name: "location", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllLocations(ctx, *opts)
+		}},
+		{Name: "location-area", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllLocationAreas(ctx, *opts)
+		}},
+		{Name: "encounter-method", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllEncounterMethods(ctx, *opts)
+		}},
+		{Name: "encounter-condition", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllEncounterConditions(ctx, *opts)
+		}},
+		{Name: "pokemon-encounter", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllPokemonEncounters(ctx, *opts)
+		}},
+	}
+
+	// Run the synchronization
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	results, err := syncer.RunStages(ctx, stages)
+	if err != nil {
+		log.Fatalf("Encounter data sync failed: %v", err)
+	}
+
+	syncer.PrintSummary(os.Stdout, results)
+
+	for _, res := range results {
+		if res.Err != nil || res.Skipped {
+			log.Println("Encounter data sync finished with failed stages.")
+			os.Exit(1) // Keluar dengan status error
+		}
+	}
+
+	log.Println("Encounter data sync completed successfully.")
+	os.Exit(0) // Keluar dengan status sukses
+}
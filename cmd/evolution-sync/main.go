@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"os"
 	"time"
@@ -10,36 +11,51 @@ import (
 	"pokedex/database"
 	"pokedex/internal/evolution/repository"
 	"pokedex/internal/evolution/service"
+	item_repo "pokedex/internal/item/repository"
+	"pokedex/internal/shared/checkpoint"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
 )
 
 func main() {
 	log.Println("Starting Pokemon Species Sync Job...")
 
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
 	// Load configuration
 	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
 
 	// Connect to MongoDB
 	database.ConnectDB(cfg)
 	defer database.DisconnectDB()
 
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
 	// Initialize shared PokeAPI client
 	pokeAPIClient := pokeapi.NewClient(cfg)
 	defer pokeAPIClient.CloseClient()
 
 	evolutionRepo := repository.NewMongoEvolutionRepository()
-	evolutionService := service.NewEvolutionService(evolutionRepo, pokeAPIClient)
+	evolutionService := service.NewEvolutionService(evolutionRepo, pokeAPIClient, item_repo.NewMongoItemRepository())
 
 	// Run the synchronization
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute) // Beri waktu yang cukup
 	defer cancel()
 
-	err := evolutionService.SyncAllEvolution(ctx)
+	stats, err := evolutionService.SyncAllEvolution(ctx, *opts)
 	if err != nil {
 		log.Fatalf("Evolution Chain sync job failed: %v", err)
 		os.Exit(1) // Keluar dengan status error
 	}
 
-	log.Println("Evolution Chain Sync Job completed successfully.")
+	log.Printf("Evolution Chain Sync Job completed successfully. %s\n", stats)
 	os.Exit(0) // Keluar dengan status sukses
 }
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+
+	"pokedex/internal/generation/repository"
+	"pokedex/internal/generation/service"
+	"pokedex/internal/shared/checkpoint"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+)
+
+func main() {
+	log.Println("Starting Generation Sync Job...")
+
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Initialize shared PokeAPI client
+	pokeAPIClient := pokeapi.NewClient(cfg)
+	defer pokeAPIClient.CloseClient()
+
+	// Initialize Generation Module
+	generationRepo := repository.NewMongoGenerationRepository()
+	generationService := service.NewGenerationService(generationRepo, pokeAPIClient)
+
+	// Version groups and versions are ordered and named independently of generations
+	stages := []syncer.Stage{
+		{Name: "generation", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return generationService.SyncAllGenerations(ctx, *opts)
+		}},
+		{Name: "version-group", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return generationService.SyncAllVersionGroups(ctx, *opts)
+		}},
+		{Name: "version", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return generationService.SyncAllVersions(ctx, *opts)
+		}},
+	}
+
+	// Run the synchronization
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	results, err := syncer.RunStages(ctx, stages)
+	if err != nil {
+		log.Fatalf("Generation data sync failed: %v", err)
+	}
+
+	syncer.PrintSummary(os.Stdout, results)
+
+	for _, res := range results {
+		if res.Err != nil || res.Skipped {
+			log.Println("Generation data sync finished with failed stages.")
+			os.Exit(1) // Keluar dengan status error
+		}
+	}
+
+	log.Println("Generation data sync completed successfully.")
+	os.Exit(0) // Keluar dengan status sukses
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+
+	"pokedex/internal/growth-rate/repository"
+	"pokedex/internal/growth-rate/service"
+	"pokedex/internal/shared/checkpoint"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+	"pokedex/internal/shared/tombstone"
+)
+
+func main() {
+	log.Println("Starting Growth Rate Sync Job...")
+
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Store which documents each full run tombstoned or saw renamed upstream
+	opts.Reports = tombstone.NewMongoReportRepository()
+
+	// Initialize shared PokeAPI client
+	pokeAPIClient := pokeapi.NewClient(cfg)
+	defer pokeAPIClient.CloseClient()
+
+	// Initialize Growth Rate Module
+	growthRateRepo := repository.NewMongoGrowthRateRepository()
+	growthRateService := service.NewGrowthRateService(growthRateRepo, pokeAPIClient)
+
+	// Run the synchronization
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	stats, err := growthRateService.SyncAllGrowthRates(ctx, *opts)
+	if err != nil {
+		log.Fatalf("Growth rate data sync failed: %v", err)
+		os.Exit(1) // Keluar dengan status error
+	}
+
+	log.Printf("Growth rate data sync completed successfully. %s\n", stats)
+	os.Exit(0) // Keluar dengan status sukses
+}
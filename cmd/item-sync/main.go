@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+
+	"pokedex/internal/item/repository"
+	"pokedex/internal/item/service"
+	"pokedex/internal/shared/checkpoint"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+	"pokedex/internal/shared/tombstone"
+)
+
+func main() {
+	log.Println("Starting Item Sync Job...")
+
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Store which documents each full run tombstoned or saw renamed upstream
+	opts.Reports = tombstone.NewMongoReportRepository()
+
+	// Initialize shared PokeAPI client
+	pokeAPIClient := pokeapi.NewClient(cfg)
+	defer pokeAPIClient.CloseClient()
+
+	// Initialize Item Module
+	itemRepo := repository.NewMongoItemRepository()
+	itemService := service.NewItemService(itemRepo, pokeAPIClient)
+
+	// Run the synchronization
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	stats, err := itemService.SyncAllItems(ctx, *opts)
+	if err != nil {
+		log.Fatalf("Item data sync failed: %v", err)
+		os.Exit(1) // Keluar dengan status error
+	}
+
+	log.Printf("Item data sync completed successfully. %s\n", stats)
+	os.Exit(0) // Keluar dengan status sukses
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"os"
 	"time"
@@ -10,19 +11,33 @@ import (
 	"pokedex/database"
 	"pokedex/internal/pokemon-species/repository"
 	"pokedex/internal/pokemon-species/service"
+	"pokedex/internal/shared/checkpoint"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
 )
 
 func main() {
 	log.Println("Starting Pokemon Species Sync Job...")
 
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
 	// Load configuration
 	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
 
 	// Connect to MongoDB
 	database.ConnectDB(cfg)
 	defer database.DisconnectDB()
 
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
 	// Initialize shared PokeAPI client
 	pokeAPIClient := pokeapi.NewClient(cfg)
 	defer pokeAPIClient.CloseClient()
@@ -34,12 +49,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute) // Beri waktu yang cukup
 	defer cancel()
 
-	err := pokemonSpeciesService.SyncAllPokemonSpecies(ctx)
+	stats, err := pokemonSpeciesService.SyncAllPokemonSpecies(ctx, *opts)
 	if err != nil {
 		log.Fatalf("Pokemon species sync job failed: %v", err)
 		os.Exit(1) // Keluar dengan status error
 	}
 
-	log.Println("Pokemon species Sync Job completed successfully.")
+	log.Printf("Pokemon species Sync Job completed successfully. %s\n", stats)
 	os.Exit(0) // Keluar dengan status sukses
 }
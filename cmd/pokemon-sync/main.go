@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"os"
 	"time"
@@ -10,28 +11,47 @@ import (
 	"pokedex/database"
 	"pokedex/internal/pokemon/repository"
 	"pokedex/internal/pokemon/service"
+	"pokedex/internal/shared/checkpoint"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+	"pokedex/internal/shared/tombstone"
 
 	evolution_repo "pokedex/internal/evolution/repository"
 	evolution_service "pokedex/internal/evolution/service"
+	item_repo "pokedex/internal/item/repository"
 )
 
 func main() {
 	log.Println("Starting Pokemon Sync Job...")
 
+	// Parse shared sync flags (e.g. --restart, --incremental)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
 	// Load configuration
 	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
 
 	// Connect to MongoDB
 	database.ConnectDB(cfg)
 	defer database.DisconnectDB()
 
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Store which documents each full run tombstoned or saw renamed upstream
+	opts.Reports = tombstone.NewMongoReportRepository()
+
 	// Initialize shared PokeAPI client
 	pokeAPIClient := pokeapi.NewClient(cfg)
 	defer pokeAPIClient.CloseClient()
 
 	evolutionRepo := evolution_repo.NewMongoEvolutionRepository()
-	evolutionService := evolution_service.NewEvolutionService(evolutionRepo, pokeAPIClient)
+	evolutionService := evolution_service.NewEvolutionService(evolutionRepo, pokeAPIClient, item_repo.NewMongoItemRepository())
 
 	// Initialize Pokemon Module Components needed for sync
 	pokemonRepo := repository.NewMongoPokemonRepository()
@@ -41,12 +61,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute) // Beri waktu yang cukup
 	defer cancel()
 
-	err := pokemonService.SyncAllPokemons(ctx)
+	stats, err := pokemonService.SyncAllPokemons(ctx, *opts)
 	if err != nil {
 		log.Fatalf("Pokemon sync job failed: %v", err)
 		os.Exit(1) // Keluar dengan status error
 	}
 
-	log.Println("Pokemon Sync Job completed successfully.")
+	log.Printf("Pokemon Sync Job completed successfully. %s\n", stats)
 	os.Exit(0) // Keluar dengan status sukses
 }
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+	"pokedex/internal/pokemon/repository"
+	"pokedex/internal/shared/spritemirror"
+)
+
+func main() {
+	log.Println("Starting Sprite Sync Job...")
+
+	thumbnailsOnly := flag.Bool("thumbnails-only", false, "mirror only the official artwork used as thumbnail")
+	force := flag.Bool("force", false, "download sprites again even if they are already mirrored")
+	cries := flag.Bool("cries", true, "also mirror the latest and legacy cries")
+	workers := flag.Int("workers", 8, "concurrent sprite downloads")
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+
+	if cfg.SpriteMirrorDir == "" {
+		log.Println("SPRITE_MIRROR_DIR is empty, sprite mirroring is disabled. Nothing to sync.")
+		os.Exit(0)
+	}
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Hour) // Beri waktu yang cukup
+	defer cancel()
+
+	// Sprite diambil dari dokumen pokemon, jadi jalankan cmd/pokemon-sync dulu
+	pokemons, err := repository.NewMongoPokemonRepository().GetAllPokemonMedia(ctx)
+	if err != nil {
+		log.Fatalf("Sprite sync failed: %v", err)
+	}
+
+	var urls []string
+	for _, p := range pokemons {
+		artwork := p.Sprites.Other.OfficialArtwork.FrontDefault
+		if artwork == nil {
+			// Pokemon yang di-sync sebelum katalog sprite ada: pakai path thumbnail PokeAPI
+			fallback := fmt.Sprintf("%spokemon/other/official-artwork/%d.png", spritemirror.UpstreamPrefix, p.ID)
+			artwork = &fallback
+		}
+		urls = append(urls, *artwork)
+		if !*thumbnailsOnly {
+			urls = append(urls, p.Sprites.URLs()...)
+		}
+		if *cries {
+			for _, cry := range []*string{p.Cries.Latest, p.Cries.Legacy} {
+				if cry != nil {
+					urls = append(urls, *cry)
+				}
+			}
+		}
+	}
+	log.Printf("Mirroring %d sprite and cry URLs of %d pokemon into %s\n", len(urls), len(pokemons), cfg.SpriteMirrorDir)
+
+	result, err := spritemirror.Download(ctx, cfg.SpriteMirrorDir, urls, *workers, *force)
+	if err != nil {
+		log.Fatalf("Sprite sync failed: %v", err)
+		os.Exit(1) // Keluar dengan status error
+	}
+	if result.Failed > 0 {
+		log.Printf("Sprite sync finished with failed downloads. %s\n", result)
+		os.Exit(1)
+	}
+
+	log.Printf("Sprite sync completed successfully. %s\n", result)
+	os.Exit(0) // Keluar dengan status sukses
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+	"pokedex/internal/shared/checkpoint"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+	"pokedex/internal/shared/tombstone"
+
+	ability_repo "pokedex/internal/ability/repository"
+	ability_service "pokedex/internal/ability/service"
+	berry_repo "pokedex/internal/berry/repository"
+	berry_service "pokedex/internal/berry/service"
+	egg_group_repo "pokedex/internal/egg-group/repository"
+	egg_group_service "pokedex/internal/egg-group/service"
+	encounter_repo "pokedex/internal/encounter/repository"
+	encounter_service "pokedex/internal/encounter/service"
+	evolution_repo "pokedex/internal/evolution/repository"
+	evolution_service "pokedex/internal/evolution/service"
+	generation_repo "pokedex/internal/generation/repository"
+	generation_service "pokedex/internal/generation/service"
+	growth_rate_repo "pokedex/internal/growth-rate/repository"
+	growth_rate_service "pokedex/internal/growth-rate/service"
+	item_repo "pokedex/internal/item/repository"
+	item_service "pokedex/internal/item/service"
+	move_repo "pokedex/internal/move/repository"
+	move_service "pokedex/internal/move/service"
+	nature_repo "pokedex/internal/nature/repository"
+	nature_service "pokedex/internal/nature/service"
+	pokedex_repo "pokedex/internal/pokedex/repository"
+	pokedex_service "pokedex/internal/pokedex/service"
+	pokemon_form_repo "pokedex/internal/pokemon-form/repository"
+	pokemon_form_service "pokedex/internal/pokemon-form/service"
+	pokemon_species_repo "pokedex/internal/pokemon-species/repository"
+	pokemon_species_service "pokedex/internal/pokemon-species/service"
+	pokemon_type_repo "pokedex/internal/pokemon-type/repository"
+	pokemon_type_service "pokedex/internal/pokemon-type/service"
+	pokemon_repo "pokedex/internal/pokemon/repository"
+	pokemon_service "pokedex/internal/pokemon/service"
+)
+
+func main() {
+	log.Println("Starting Full Sync Job...")
+
+	// Parse shared sync flags (e.g. --restart applies to every stage)
+	opts := syncer.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+	opts.ApplyConfig(cfg)
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	// Persist progress so an interrupted sync resumes from the last completed batch
+	opts.Checkpoints = checkpoint.NewMongoCheckpointRepository()
+
+	// Dead-letter items that could not be fetched or saved (replay with cmd/sync-retry)
+	opts.Failures = syncfailure.NewMongoFailureRepository()
+
+	// Store which documents each full run tombstoned or saw renamed upstream
+	opts.Reports = tombstone.NewMongoReportRepository()
+
+	// Initialize shared PokeAPI client (all stages share the same rate limit)
+	pokeAPIClient := pokeapi.NewClient(cfg)
+	defer pokeAPIClient.CloseClient()
+
+	pokemonTypeService := pokemon_type_service.NewPokemonTypeService(pokemon_type_repo.NewMongoPokemonTypeRepository(), pokeAPIClient)
+	pokemonSpeciesService := pokemon_species_service.NewPokemonSpeciesService(pokemon_species_repo.NewMongoPokemonSpeciesRepository(), pokeAPIClient)
+	evolutionService := evolution_service.NewEvolutionService(evolution_repo.NewMongoEvolutionRepository(), pokeAPIClient, item_repo.NewMongoItemRepository())
+	pokemonService := pokemon_service.NewPokemonService(pokemon_repo.NewMongoPokemonRepository(), pokeAPIClient, evolutionService)
+	abilityService := ability_service.NewAbilityService(ability_repo.NewMongoAbilityRepository(), pokeAPIClient)
+	moveService := move_service.NewMoveService(move_repo.NewMongoMoveRepository(), pokeAPIClient)
+	itemService := item_service.NewItemService(item_repo.NewMongoItemRepository(), pokeAPIClient)
+	encounterService := encounter_service.NewEncounterService(encounter_repo.NewMongoEncounterRepository(), pokeAPIClient)
+	natureService := nature_service.NewNatureService(nature_repo.NewMongoNatureRepository(), pokeAPIClient)
+	generationService := generation_service.NewGenerationService(generation_repo.NewMongoGenerationRepository(), pokeAPIClient)
+	pokedexService := pokedex_service.NewPokedexService(pokedex_repo.NewMongoPokedexRepository(), pokeAPIClient)
+	eggGroupService := egg_group_service.NewEggGroupService(egg_group_repo.NewMongoEggGroupRepository(), pokeAPIClient)
+	berryService := berry_service.NewBerryService(berry_repo.NewMongoBerryRepository(), pokeAPIClient)
+	growthRateService := growth_rate_service.NewGrowthRateService(growth_rate_repo.NewMongoGrowthRateRepository(), pokeAPIClient)
+	pokemonFormService := pokemon_form_service.NewPokemonFormService(pokemon_form_repo.NewMongoPokemonFormRepository(), pokeAPIClient)
+
+	// Pokemon detail joins against pokemon-species, and evolution chains are
+	// populated from the pokemons collection, so those stages must wait.
+	stages := []syncer.Stage{
+		{Name: "pokemon-type", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return pokemonTypeService.SyncAllPokemonType(ctx, *opts)
+		}},
+		{Name: "pokemon-species", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return pokemonSpeciesService.SyncAllPokemonSpecies(ctx, *opts)
+		}},
+		{Name: "pokemon", DependsOn: []string{"pokemon-species"}, Run: func(ctx context.Context) (syncer.Stats, error) {
+			return pokemonService.SyncAllPokemons(ctx, *opts)
+		}},
+		{Name: "evolution", DependsOn: []string{"pokemon"}, Run: func(ctx context.Context) (syncer.Stats, error) {
+			return evolutionService.SyncAllEvolution(ctx, *opts)
+		}},
+		{Name: "ability", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return abilityService.SyncAllAbilities(ctx, *opts)
+		}},
+		{Name: "move", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return moveService.SyncAllMoves(ctx, *opts)
+		}},
+		{Name: "item", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return itemService.SyncAllItems(ctx, *opts)
+		}},
+		{Name: "location", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllLocations(ctx, *opts)
+		}},
+		{Name: "location-area", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllLocationAreas(ctx, *opts)
+		}},
+		{Name: "encounter-method", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllEncounterMethods(ctx, *opts)
+		}},
+		{Name: "encounter-condition", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllEncounterConditions(ctx, *opts)
+		}},
+		{Name: "pokemon-encounter", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return encounterService.SyncAllPokemonEncounters(ctx, *opts)
+		}},
+		{Name: "nature", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return natureService.SyncAllNatures(ctx, *opts)
+		}},
+		{Name: "generation", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return generationService.SyncAllGenerations(ctx, *opts)
+		}},
+		{Name: "version-group", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return generationService.SyncAllVersionGroups(ctx, *opts)
+		}},
+		{Name: "version", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return generationService.SyncAllVersions(ctx, *opts)
+		}},
+		{Name: "pokedex", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return pokedexService.SyncAllPokedexes(ctx, *opts)
+		}},
+		{Name: "egg-group", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return eggGroupService.SyncAllEggGroups(ctx, *opts)
+		}},
+		{Name: "berry", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return berryService.SyncAllBerries(ctx, *opts)
+		}},
+		{Name: "berry-flavor", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return berryService.SyncAllBerryFlavors(ctx, *opts)
+		}},
+		{Name: "growth-rate", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return growthRateService.SyncAllGrowthRates(ctx, *opts)
+		}},
+		{Name: "pokemon-form", Run: func(ctx context.Context) (syncer.Stats, error) {
+			return pokemonFormService.SyncAllPokemonForms(ctx, *opts)
+		}},
+	}
+
+	// Run the synchronization
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Hour) // Beri waktu yang cukup
+	defer cancel()
+
+	results, err := syncer.RunStages(ctx, stages)
+	if err != nil {
+		log.Fatalf("Full sync job failed: %v", err)
+	}
+
+	syncer.PrintSummary(os.Stdout, results)
+
+	for _, res := range results {
+		if res.Err != nil || res.Skipped {
+			log.Println("Full Sync Job finished with failed stages.")
+			os.Exit(1) // Keluar dengan status error
+		}
+	}
+
+	log.Println("Full Sync Job completed successfully.")
+	os.Exit(0) // Keluar dengan status sukses
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"pokedex/config"
+	"pokedex/database"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/syncfailure"
+
+	ability_repo "pokedex/internal/ability/repository"
+	ability_service "pokedex/internal/ability/service"
+	berry_repo "pokedex/internal/berry/repository"
+	berry_service "pokedex/internal/berry/service"
+	egg_group_repo "pokedex/internal/egg-group/repository"
+	egg_group_service "pokedex/internal/egg-group/service"
+	encounter_repo "pokedex/internal/encounter/repository"
+	encounter_service "pokedex/internal/encounter/service"
+	evolution_repo "pokedex/internal/evolution/repository"
+	evolution_service "pokedex/internal/evolution/service"
+	generation_repo "pokedex/internal/generation/repository"
+	generation_service "pokedex/internal/generation/service"
+	growth_rate_repo "pokedex/internal/growth-rate/repository"
+	growth_rate_service "pokedex/internal/growth-rate/service"
+	item_repo "pokedex/internal/item/repository"
+	item_service "pokedex/internal/item/service"
+	move_repo "pokedex/internal/move/repository"
+	move_service "pokedex/internal/move/service"
+	nature_repo "pokedex/internal/nature/repository"
+	nature_service "pokedex/internal/nature/service"
+	pokedex_repo "pokedex/internal/pokedex/repository"
+	pokedex_service "pokedex/internal/pokedex/service"
+	pokemon_form_repo "pokedex/internal/pokemon-form/repository"
+	pokemon_form_service "pokedex/internal/pokemon-form/service"
+	pokemon_species_repo "pokedex/internal/pokemon-species/repository"
+	pokemon_species_service "pokedex/internal/pokemon-species/service"
+	pokemon_type_repo "pokedex/internal/pokemon-type/repository"
+	pokemon_type_service "pokedex/internal/pokemon-type/service"
+	pokemon_repo "pokedex/internal/pokemon/repository"
+	pokemon_service "pokedex/internal/pokemon/service"
+)
+
+func main() {
+	log.Println("Starting Sync Retry Job...")
+
+	resource := flag.String("resource", "", "only replay failures of this resource (e.g. pokemon, ability)")
+	flag.Parse()
+
+	// Load configuration
+	cfg := config.LoadConfig()
+
+	// Connect to MongoDB
+	database.ConnectDB(cfg)
+	defer database.DisconnectDB()
+
+	// Initialize shared PokeAPI client
+	pokeAPIClient := pokeapi.NewClient(cfg)
+	defer pokeAPIClient.CloseClient()
+
+	failureRepo := syncfailure.NewMongoFailureRepository()
+
+	evolutionService := evolution_service.NewEvolutionService(evolution_repo.NewMongoEvolutionRepository(), pokeAPIClient, item_repo.NewMongoItemRepository())
+	generationService := generation_service.NewGenerationService(generation_repo.NewMongoGenerationRepository(), pokeAPIClient)
+	encounterService := encounter_service.NewEncounterService(encounter_repo.NewMongoEncounterRepository(), pokeAPIClient)
+	berryService := berry_service.NewBerryService(berry_repo.NewMongoBerryRepository(), pokeAPIClient)
+
+	// Replay every failure through the same service that dead-lettered it
+	syncOne := map[string]func(ctx context.Context, url string) error{
+		"pokemon-type":        pokemon_type_service.NewPokemonTypeService(pokemon_type_repo.NewMongoPokemonTypeRepository(), pokeAPIClient).SyncPokemonType,
+		"pokemon-species":     pokemon_species_service.NewPokemonSpeciesService(pokemon_species_repo.NewMongoPokemonSpeciesRepository(), pokeAPIClient).SyncPokemonSpecies,
+		"pokemon":             pokemon_service.NewPokemonService(pokemon_repo.NewMongoPokemonRepository(), pokeAPIClient, evolutionService).SyncPokemon,
+		"evolution":           evolutionService.SyncEvolution,
+		"ability":             ability_service.NewAbilityService(ability_repo.NewMongoAbilityRepository(), pokeAPIClient).SyncAbility,
+		"move":                move_service.NewMoveService(move_repo.NewMongoMoveRepository(), pokeAPIClient).SyncMove,
+		"item":                item_service.NewItemService(item_repo.NewMongoItemRepository(), pokeAPIClient).SyncItem,
+		"location":            encounterService.SyncLocation,
+		"location-area":       encounterService.SyncLocationArea,
+		"encounter-method":    encounterService.SyncEncounterMethod,
+		"encounter-condition": encounterService.SyncEncounterCondition,
+		"pokemon-encounter":   encounterService.SyncPokemonEncounters,
+		"nature":              nature_service.NewNatureService(nature_repo.NewMongoNatureRepository(), pokeAPIClient).SyncNature,
+		"generation":          generationService.SyncGeneration,
+		"version-group":       generationService.SyncVersionGroup,
+		"version":             generationService.SyncVersion,
+		"pokedex":             pokedex_service.NewPokedexService(pokedex_repo.NewMongoPokedexRepository(), pokeAPIClient).SyncPokedex,
+		"egg-group":           egg_group_service.NewEggGroupService(egg_group_repo.NewMongoEggGroupRepository(), pokeAPIClient).SyncEggGroup,
+		"berry":               berryService.SyncBerry,
+		"berry-flavor":        berryService.SyncBerryFlavor,
+		"growth-rate":         growth_rate_service.NewGrowthRateService(growth_rate_repo.NewMongoGrowthRateRepository(), pokeAPIClient).SyncGrowthRate,
+		"pokemon-form":        pokemon_form_service.NewPokemonFormService(pokemon_form_repo.NewMongoPokemonFormRepository(), pokeAPIClient).SyncPokemonForm,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Minute)
+	defer cancel()
+
+	failures, err := failureRepo.ListFailures(ctx, *resource)
+	if err != nil {
+		log.Fatalf("Sync retry job failed: %v", err)
+	}
+	log.Printf("Replaying %d failed sync item(s)...\n", len(failures))
+
+	statsByResource := make(map[string]*syncer.Stats)
+	var order []string
+
+	for _, failure := range failures {
+		stats, ok := statsByResource[failure.Resource]
+		if !ok {
+			stats = &syncer.Stats{Resource: failure.Resource}
+			statsByResource[failure.Resource] = stats
+			order = append(order, failure.Resource)
+		}
+
+		sync, ok := syncOne[failure.Resource]
+		if !ok {
+			log.Printf("Warning: no sync service for resource %q, leaving %s in place.\n", failure.Resource, failure.URL)
+			stats.Failed++
+			continue
+		}
+
+		start := time.Now()
+		err := sync(ctx, failure.URL)
+		stats.Duration += time.Since(start)
+		if err != nil {
+			stats.Failed++
+			log.Printf("Retry failed for %s %s (attempt %d): %v\n", failure.Resource, failure.URL, failure.Attempts+1, err)
+			class := pokeapi.ErrorClass(err)
+			if class == "unknown" {
+				class = syncer.ErrorClassSave // not a PokeAPI client error, so it came from the repository
+			}
+			if recordErr := failureRepo.RecordFailure(ctx, failure.Resource, failure.URL, class, err.Error()); recordErr != nil {
+				log.Printf("Warning: %v\n", recordErr)
+			}
+			continue
+		}
+
+		stats.Fetched++
+		stats.Saved++
+		if err := failureRepo.DeleteFailure(ctx, failure.Resource, failure.URL); err != nil {
+			log.Printf("Warning: %v\n", err)
+		}
+	}
+
+	results := make([]syncer.StageResult, 0, len(order))
+	remaining := 0
+	for _, name := range order {
+		results = append(results, syncer.StageResult{Name: name, Stats: *statsByResource[name]})
+		remaining += statsByResource[name].Failed
+	}
+	syncer.PrintSummary(os.Stdout, results)
+
+	if remaining > 0 {
+		log.Printf("Sync Retry Job finished with %d item(s) still failing.\n", remaining)
+		os.Exit(1) // Keluar dengan status error
+	}
+
+	log.Println("Sync Retry Job completed successfully.")
+	os.Exit(0) // Keluar dengan status sukses
+}
@@ -13,7 +13,24 @@ type Config struct {
 	MongoURI    string
 	MongoDBName string
 	PokeAPIURL  string
-	RateLimitMs int
+	RateLimitMs int // Sustained rate: one PokeAPI request per RateLimitMs on average
+
+	PokeAPIRateBurst int // Requests that may go out back-to-back before RateLimitMs applies
+	PokeAPIWorkers   int // Concurrent HTTP requests in flight
+
+	PokeAPISource string // "http" (default) or file:///path/to/api-data for an offline dump
+
+	PokeAPICacheDir   string // On-disk conditional-GET cache; empty disables it
+	PokeAPICacheMaxMB int
+
+	PokeAPIMaxRetries  int // Retries per request for 429, 5xx and network errors
+	PokeAPIRetryBaseMs int // First backoff delay, doubled on every retry
+
+	SyncFreshnessTTLHours int // Documents younger than this are skipped by incremental syncs
+	SyncConcurrency       int // Detail fetches a sync keeps in flight per resource
+
+	SpriteMirrorDir     string // Local copy of the PokeAPI sprites (cmd/sprite-sync); empty disables mirroring
+	SpriteMirrorBaseURL string // Public URL of SpriteMirrorDir, e.g. a CDN or bucket in front of it
 }
 
 func LoadConfig() *Config {
@@ -27,7 +44,24 @@ func LoadConfig() *Config {
 		MongoURI:    getEnv("MONGO_URI", "mongodb://localhost:27017"),
 		MongoDBName: getEnv("MONGO_DB_NAME", "pokemondb"),
 		PokeAPIURL:  getEnv("POKEAPI_URL", "https://pokeapi.co/api/v2"),
-		RateLimitMs: getEnvAsInt("POKEAPI_RATE_LIMIT_MS", 1000),
+		RateLimitMs: getEnvAsInt("POKEAPI_RATE_LIMIT_MS", 100),
+
+		PokeAPIRateBurst: getEnvAsInt("POKEAPI_RATE_BURST", 10),
+		PokeAPIWorkers:   getEnvAsInt("POKEAPI_WORKERS", 4),
+
+		PokeAPISource: getEnv("POKEAPI_SOURCE", "http"),
+
+		PokeAPICacheDir:   getEnv("POKEAPI_CACHE_DIR", ".cache/pokeapi"),
+		PokeAPICacheMaxMB: getEnvAsInt("POKEAPI_CACHE_MAX_MB", 1024),
+
+		PokeAPIMaxRetries:  getEnvAsInt("POKEAPI_MAX_RETRIES", 4),
+		PokeAPIRetryBaseMs: getEnvAsInt("POKEAPI_RETRY_BASE_MS", 500),
+
+		SyncFreshnessTTLHours: getEnvAsInt("SYNC_FRESHNESS_TTL_HOURS", 168),
+		SyncConcurrency:       getEnvAsInt("SYNC_CONCURRENCY", 16),
+
+		SpriteMirrorDir:     getEnv("SPRITE_MIRROR_DIR", ""),
+		SpriteMirrorBaseURL: getEnv("SPRITE_MIRROR_BASE_URL", "/assets/sprites"),
 	}
 }
 
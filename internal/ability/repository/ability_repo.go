@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"pokedex/database"
 	"pokedex/internal/ability/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -19,6 +21,9 @@ type AbilityRepository interface {
 	SaveAbility(ctx context.Context, ability model.AbilityDetail) error
 	GetAbilityByID(ctx context.Context, id int) (model.AbilityDetail, error)
 	GetAbilityByName(ctx context.Context, name string) (model.AbilityDetail, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
 }
 
 // MongoAbilityRepository implements the AbilityRepository interface for MongoDB.
@@ -50,7 +55,10 @@ func (r *MongoAbilityRepository) SaveAbility(ctx context.Context, ability model.
 	}
 
 	filter := bson.M{"id": doc.AbilityID} // Filter berdasarkan ID PokeAPI
-	update := bson.M{"$set": doc}         // Menggunakan $set untuk memperbarui atau menyisipkan seluruh dokumen
+	update := bson.M{
+		"$set":   doc,                         // Menggunakan $set untuk memperbarui atau menyisipkan seluruh dokumen
+		"$unset": bson.M{tombstone.Field: ""}, // Ability yang muncul lagi di upstream tidak lagi disembunyikan
+	}
 
 	opts := options.Update().SetUpsert(true) // Opsi upsert: jika tidak ada, sisipkan; jika ada, perbarui.
 
@@ -64,7 +72,7 @@ func (r *MongoAbilityRepository) SaveAbility(ctx context.Context, ability model.
 // GetAbilityByID retrieves an ability by its original PokeAPI ID from MongoDB.
 func (r *MongoAbilityRepository) GetAbilityByID(ctx context.Context, id int) (model.AbilityDetail, error) {
 	var doc model.AbilityDocument
-	filter := bson.M{"id": id} // Mencari berdasarkan PokeAPI ID
+	filter := tombstone.Active(bson.M{"id": id}) // Mencari berdasarkan PokeAPI ID
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -78,7 +86,7 @@ func (r *MongoAbilityRepository) GetAbilityByID(ctx context.Context, id int) (mo
 // GetAbilityByName retrieves an ability by its name from MongoDB.
 func (r *MongoAbilityRepository) GetAbilityByName(ctx context.Context, name string) (model.AbilityDetail, error) {
 	var doc model.AbilityDocument
-	filter := bson.M{"name": name} // Mencari berdasarkan nama Ability
+	filter := tombstone.Active(bson.M{"name": name}) // Mencari berdasarkan nama Ability
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -90,7 +98,8 @@ func (r *MongoAbilityRepository) GetAbilityByName(ctx context.Context, name stri
 }
 
 func (r *MongoAbilityRepository) GetAbilityList(ctx context.Context, limit, offset int) ([]model.AbilityDetail, int64, error) {
-	totalCount, err := r.collection.CountDocuments(ctx, bson.M{})
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count abilitys in DB: %w", err)
 	}
@@ -100,7 +109,7 @@ func (r *MongoAbilityRepository) GetAbilityList(ctx context.Context, limit, offs
 	findOptions.SetSkip(int64(offset))
 	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual ability ID
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve ability list from DB: %w", err)
 	}
@@ -119,6 +128,21 @@ func (r *MongoAbilityRepository) GetAbilityList(ctx context.Context, limit, offs
 	return abilityDetails, totalCount, nil
 }
 
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored ability whose ID is in ids.
+func (r *MongoAbilityRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every ability that is not tombstoned.
+func (r *MongoAbilityRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every ability whose ID was not seen by a full sync.
+func (r *MongoAbilityRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
 // toDetail helper function converts an AbilityDocument to an AbilityDetail model.
 // This is useful if your internal document structure differs slightly from the API model.
 func (r *MongoAbilityRepository) toDetail(doc model.AbilityDocument) model.AbilityDetail {
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/berry/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BerryHandler struct {
+	berryService service.BerryService
+}
+
+func NewBerryHandler(svc service.BerryService) *BerryHandler {
+	return &BerryHandler{
+		berryService: svc,
+	}
+}
+
+func (h *BerryHandler) GetBerryList(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	// Optional filters, e.g. ?flavor=spicy&firmness=soft
+	flavor := strings.ToLower(c.Query("flavor"))
+	firmness := strings.ToLower(c.Query("firmness"))
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	listResponse, err := h.berryService.GetBerryList(ctx, limit, offset, flavor, firmness, baseURL(c, "berry"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve berry list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *BerryHandler) GetBerryDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	berry, err := h.berryService.GetBerry(ctx, identifier)
+	if err != nil {
+		// More robust error checking for "not found"
+		if err.Error() == fmt.Sprintf("berry not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve berry detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, berry)
+}
+
+func (h *BerryHandler) GetBerryFlavorList(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	listResponse, err := h.berryService.GetBerryFlavorList(ctx, limit, offset, baseURL(c, "berry-flavor"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve berry flavor list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *BerryHandler) GetBerryFlavorDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	flavor, err := h.berryService.GetBerryFlavor(ctx, identifier)
+	if err != nil {
+		if err.Error() == fmt.Sprintf("berry flavor not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve berry flavor detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, flavor)
+}
+
+// pagination reads ?limit= (default 20) and ?offset= (default 0).
+func pagination(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// baseURL builds the absolute URL of a v1 resource from the request's scheme and host.
+func baseURL(c *gin.Context, resource string) string {
+	// Mendapatkan skema (http/https), host, dan path dasar dari request
+	scheme := "http"
+	if c.Request.TLS != nil { // Cek apakah koneksi menggunakan HTTPS
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/api/v1/%s", scheme, c.Request.Host, resource)
+}
@@ -0,0 +1,137 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+func (n NameEntry) LanguageName() string  { return n.Language.Name }
+func (n NameEntry) LocalizedText() string { return n.Name }
+
+// BerryFlavorMap is the potency of one flavor in a berry.
+type BerryFlavorMap struct {
+	Potency int               `json:"potency" bson:"potency"`
+	Flavor  ResourceReference `json:"flavor" bson:"flavor"`
+}
+
+// BerryDetail is a berry as returned by PokeAPI /berry/{id}.
+type BerryDetail struct {
+	ID               int               `json:"id" bson:"id"`
+	Name             string            `json:"name" bson:"name"`
+	GrowthTime       int               `json:"growth_time" bson:"growth_time"`
+	MaxHarvest       int               `json:"max_harvest" bson:"max_harvest"`
+	NaturalGiftPower int               `json:"natural_gift_power" bson:"natural_gift_power"`
+	NaturalGiftType  ResourceReference `json:"natural_gift_type" bson:"natural_gift_type"`
+	Size             int               `json:"size" bson:"size"`
+	Smoothness       int               `json:"smoothness" bson:"smoothness"`
+	SoilDryness      int               `json:"soil_dryness" bson:"soil_dryness"`
+	Firmness         ResourceReference `json:"firmness" bson:"firmness"`
+	Flavors          []BerryFlavorMap  `json:"flavors" bson:"flavors"`
+	Item             ResourceReference `json:"item" bson:"item"`
+}
+
+// FlavorBerryMap is the potency of a flavor in one berry.
+type FlavorBerryMap struct {
+	Potency int               `json:"potency" bson:"potency"`
+	Berry   ResourceReference `json:"berry" bson:"berry"`
+}
+
+// BerryFlavorDetail is a berry flavor as returned by PokeAPI /berry-flavor/{id}.
+type BerryFlavorDetail struct {
+	ID          int               `json:"id" bson:"id"`
+	Name        string            `json:"name" bson:"name"`
+	Berries     []FlavorBerryMap  `json:"berries" bson:"berries"`
+	ContestType ResourceReference `json:"contest_type" bson:"contest_type"`
+	Names       []NameEntry       `json:"names" bson:"names"`
+}
+
+// BerryFlavorPotency is one flavor of a berry in the API response.
+type BerryFlavorPotency struct {
+	Flavor  string `json:"flavor"`
+	Potency int    `json:"potency"`
+}
+
+// BerryResponse is what /api/v1/berry returns for a single berry.
+type BerryResponse struct {
+	ID               int                  `json:"id"`
+	Name             string               `json:"name"`
+	Item             string               `json:"item"`
+	GrowthTime       int                  `json:"growth_time"`
+	MaxHarvest       int                  `json:"max_harvest"`
+	NaturalGiftPower int                  `json:"natural_gift_power"`
+	NaturalGiftType  string               `json:"natural_gift_type"`
+	Size             int                  `json:"size"`
+	Smoothness       int                  `json:"smoothness"`
+	SoilDryness      int                  `json:"soil_dryness"`
+	Firmness         string               `json:"firmness"`
+	Flavors          []BerryFlavorPotency `json:"flavors"`
+}
+
+// BerryListResponse
+type BerryListResponse struct {
+	Count    int             `json:"count"`
+	Next     *string         `json:"next"`
+	Previous *string         `json:"previous"`
+	Results  []BerryResponse `json:"results"`
+}
+
+// FlavorBerryPotency is one berry carrying a flavor in the API response.
+type FlavorBerryPotency struct {
+	Berry   string `json:"berry"`
+	Potency int    `json:"potency"`
+}
+
+// BerryFlavorResponse is what /api/v1/berry-flavor returns for a single flavor,
+// with its berries sorted from strongest to weakest.
+type BerryFlavorResponse struct {
+	ID          int                  `json:"id"`
+	Name        string               `json:"name"`
+	DisplayName string               `json:"display_name"`
+	ContestType string               `json:"contest_type"`
+	Berries     []FlavorBerryPotency `json:"berries"`
+}
+
+// BerryFlavorListResponse
+type BerryFlavorListResponse struct {
+	Count    int                   `json:"count"`
+	Next     *string               `json:"next"`
+	Previous *string               `json:"previous"`
+	Results  []BerryFlavorResponse `json:"results"`
+}
+
+// BerryDocument is the structure to store in MongoDB
+type BerryDocument struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	BerryID          int                `bson:"id"`
+	Name             string             `bson:"name"`
+	GrowthTime       int                `bson:"growth_time"`
+	MaxHarvest       int                `bson:"max_harvest"`
+	NaturalGiftPower int                `bson:"natural_gift_power"`
+	NaturalGiftType  ResourceReference  `bson:"natural_gift_type"`
+	Size             int                `bson:"size"`
+	Smoothness       int                `bson:"smoothness"`
+	SoilDryness      int                `bson:"soil_dryness"`
+	Firmness         ResourceReference  `bson:"firmness"`
+	Flavors          []BerryFlavorMap   `bson:"flavors"`
+	Item             ResourceReference  `bson:"item"`
+	LastSyncedAt     int64              `bson:"last_synced_at"`
+}
+
+// BerryFlavorDocument is the structure to store in MongoDB
+type BerryFlavorDocument struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	BerryFlavorID int                `bson:"id"`
+	Name          string             `bson:"name"`
+	Berries       []FlavorBerryMap   `bson:"berries"`
+	ContestType   ResourceReference  `bson:"contest_type"`
+	Names         []NameEntry        `bson:"names"`
+	LastSyncedAt  int64              `bson:"last_synced_at"`
+}
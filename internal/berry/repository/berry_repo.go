@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/berry/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	berryCollectionName       = "berries"
+	berryFlavorCollectionName = "berry_flavors"
+)
+
+// BerryRepository defines the interface for persisting and retrieving berries and berry flavors.
+type BerryRepository interface {
+	SaveBerry(ctx context.Context, berry model.BerryDetail) error
+	SaveBerryFlavor(ctx context.Context, flavor model.BerryFlavorDetail) error
+
+	GetBerryByID(ctx context.Context, id int) (model.BerryDetail, error)
+	GetBerryByName(ctx context.Context, name string) (model.BerryDetail, error)
+	GetBerryList(ctx context.Context, limit, offset int, flavor, firmness string) ([]model.BerryDetail, int64, error)
+	GetBerryFlavorByID(ctx context.Context, id int) (model.BerryFlavorDetail, error)
+	GetBerryFlavorByName(ctx context.Context, name string) (model.BerryFlavorDetail, error)
+	GetBerryFlavorList(ctx context.Context, limit, offset int) ([]model.BerryFlavorDetail, int64, error)
+
+	GetBerryLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetBerryFlavorLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetBerryActiveNames(ctx context.Context) (map[int]string, error)
+	GetBerryFlavorActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileBerryTombstones(ctx context.Context, seenIDs []int) error
+	ReconcileBerryFlavorTombstones(ctx context.Context, seenIDs []int) error
+}
+
+// MongoBerryRepository implements the BerryRepository interface for MongoDB.
+type MongoBerryRepository struct {
+	collection       *mongo.Collection
+	flavorCollection *mongo.Collection
+}
+
+// NewMongoBerryRepository creates a new MongoDB repository for berries.
+func NewMongoBerryRepository() *MongoBerryRepository {
+	return &MongoBerryRepository{
+		collection:       database.MongoDatabase.Collection(berryCollectionName),
+		flavorCollection: database.MongoDatabase.Collection(berryFlavorCollectionName),
+	}
+}
+
+// SaveBerry saves a berry, upserting on 'id'.
+func (r *MongoBerryRepository) SaveBerry(ctx context.Context, berry model.BerryDetail) error {
+	doc := model.BerryDocument{
+		BerryID:          berry.ID,
+		Name:             berry.Name,
+		GrowthTime:       berry.GrowthTime,
+		MaxHarvest:       berry.MaxHarvest,
+		NaturalGiftPower: berry.NaturalGiftPower,
+		NaturalGiftType:  berry.NaturalGiftType,
+		Size:             berry.Size,
+		Smoothness:       berry.Smoothness,
+		SoilDryness:      berry.SoilDryness,
+		Firmness:         berry.Firmness,
+		Flavors:          berry.Flavors,
+		Item:             berry.Item,
+		LastSyncedAt:     time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.BerryID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save berry %s (ID: %d) to MongoDB: %w", berry.Name, berry.ID, err)
+	}
+	return nil
+}
+
+// SaveBerryFlavor saves a berry flavor, upserting on 'id'.
+func (r *MongoBerryRepository) SaveBerryFlavor(ctx context.Context, flavor model.BerryFlavorDetail) error {
+	doc := model.BerryFlavorDocument{
+		BerryFlavorID: flavor.ID,
+		Name:          flavor.Name,
+		Berries:       flavor.Berries,
+		ContestType:   flavor.ContestType,
+		Names:         flavor.Names,
+		LastSyncedAt:  time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.BerryFlavorID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.flavorCollection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save berry flavor %s (ID: %d) to MongoDB: %w", flavor.Name, flavor.ID, err)
+	}
+	return nil
+}
+
+// GetBerryByID retrieves a berry by its original PokeAPI ID from MongoDB.
+func (r *MongoBerryRepository) GetBerryByID(ctx context.Context, id int) (model.BerryDetail, error) {
+	var doc model.BerryDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.BerryDetail{}, fmt.Errorf("berry not found: %d", id)
+		}
+		return model.BerryDetail{}, fmt.Errorf("failed to retrieve berry by ID from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetBerryByName retrieves a berry by its name (e.g. "cheri") from MongoDB.
+func (r *MongoBerryRepository) GetBerryByName(ctx context.Context, name string) (model.BerryDetail, error) {
+	var doc model.BerryDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.BerryDetail{}, fmt.Errorf("berry not found: %s", name)
+		}
+		return model.BerryDetail{}, fmt.Errorf("failed to retrieve berry by name from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetBerryList returns a page of berries sorted by ID. A non-empty flavor keeps
+// only berries with a positive potency of that flavor; a non-empty firmness keeps
+// only berries of that firmness.
+func (r *MongoBerryRepository) GetBerryList(ctx context.Context, limit, offset int, flavor, firmness string) ([]model.BerryDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	if flavor != "" {
+		filter["flavors"] = bson.M{"$elemMatch": bson.M{"flavor.name": flavor, "potency": bson.M{"$gt": 0}}}
+	}
+	if firmness != "" {
+		filter["firmness.name"] = firmness
+	}
+
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count berries in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual berry ID
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve berry list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var berryDocs []model.BerryDocument
+	if err = cursor.All(ctx, &berryDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode berry list from DB: %w", err)
+	}
+
+	var berries []model.BerryDetail
+	for _, doc := range berryDocs {
+		berries = append(berries, r.toDetail(doc))
+	}
+
+	return berries, totalCount, nil
+}
+
+// GetBerryFlavorByID retrieves a berry flavor by its original PokeAPI ID from MongoDB.
+func (r *MongoBerryRepository) GetBerryFlavorByID(ctx context.Context, id int) (model.BerryFlavorDetail, error) {
+	var doc model.BerryFlavorDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.flavorCollection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.BerryFlavorDetail{}, fmt.Errorf("berry flavor not found: %d", id)
+		}
+		return model.BerryFlavorDetail{}, fmt.Errorf("failed to retrieve berry flavor by ID from DB: %w", err)
+	}
+	return r.toFlavorDetail(doc), nil
+}
+
+// GetBerryFlavorByName retrieves a berry flavor by its name (e.g. "spicy") from MongoDB.
+func (r *MongoBerryRepository) GetBerryFlavorByName(ctx context.Context, name string) (model.BerryFlavorDetail, error) {
+	var doc model.BerryFlavorDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.flavorCollection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.BerryFlavorDetail{}, fmt.Errorf("berry flavor not found: %s", name)
+		}
+		return model.BerryFlavorDetail{}, fmt.Errorf("failed to retrieve berry flavor by name from DB: %w", err)
+	}
+	return r.toFlavorDetail(doc), nil
+}
+
+// GetBerryFlavorList returns a page of berry flavors sorted by ID.
+func (r *MongoBerryRepository) GetBerryFlavorList(ctx context.Context, limit, offset int) ([]model.BerryFlavorDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.flavorCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count berry flavors in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual berry flavor ID
+
+	cursor, err := r.flavorCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve berry flavor list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var flavorDocs []model.BerryFlavorDocument
+	if err = cursor.All(ctx, &flavorDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode berry flavor list from DB: %w", err)
+	}
+
+	var flavors []model.BerryFlavorDetail
+	for _, doc := range flavorDocs {
+		flavors = append(flavors, r.toFlavorDetail(doc))
+	}
+
+	return flavors, totalCount, nil
+}
+
+// GetBerryLastSyncedAt returns the last_synced_at timestamp of every stored berry whose ID is in ids.
+func (r *MongoBerryRepository) GetBerryLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetBerryFlavorLastSyncedAt returns the last_synced_at timestamp of every stored berry flavor whose ID is in ids.
+func (r *MongoBerryRepository) GetBerryFlavorLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.flavorCollection, ids)
+}
+
+// GetBerryActiveNames returns the id -> name map of every berry that is not tombstoned.
+func (r *MongoBerryRepository) GetBerryActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// GetBerryFlavorActiveNames returns the id -> name map of every berry flavor that is not tombstoned.
+func (r *MongoBerryRepository) GetBerryFlavorActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.flavorCollection)
+}
+
+// ReconcileBerryTombstones hides every berry whose ID was not seen by a full sync.
+func (r *MongoBerryRepository) ReconcileBerryTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// ReconcileBerryFlavorTombstones hides every berry flavor whose ID was not seen by a full sync.
+func (r *MongoBerryRepository) ReconcileBerryFlavorTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.flavorCollection, seenIDs)
+}
+
+// toDetail converts a BerryDocument to a model.BerryDetail.
+func (r *MongoBerryRepository) toDetail(doc model.BerryDocument) model.BerryDetail {
+	return model.BerryDetail{
+		ID:               doc.BerryID,
+		Name:             doc.Name,
+		GrowthTime:       doc.GrowthTime,
+		MaxHarvest:       doc.MaxHarvest,
+		NaturalGiftPower: doc.NaturalGiftPower,
+		NaturalGiftType:  doc.NaturalGiftType,
+		Size:             doc.Size,
+		Smoothness:       doc.Smoothness,
+		SoilDryness:      doc.SoilDryness,
+		Firmness:         doc.Firmness,
+		Flavors:          doc.Flavors,
+		Item:             doc.Item,
+	}
+}
+
+// toFlavorDetail converts a BerryFlavorDocument to a model.BerryFlavorDetail.
+func (r *MongoBerryRepository) toFlavorDetail(doc model.BerryFlavorDocument) model.BerryFlavorDetail {
+	return model.BerryFlavorDetail{
+		ID:          doc.BerryFlavorID,
+		Name:        doc.Name,
+		Berries:     doc.Berries,
+		ContestType: doc.ContestType,
+		Names:       doc.Names,
+	}
+}
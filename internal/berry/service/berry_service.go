@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"pokedex/internal/berry/model"
+	"pokedex/internal/berry/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BerryService defines the business logic for berries and berry flavors.
+type BerryService interface {
+	SyncAllBerries(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncAllBerryFlavors(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncBerry(ctx context.Context, url string) error
+	SyncBerryFlavor(ctx context.Context, url string) error
+
+	GetBerry(ctx context.Context, identifier string) (model.BerryResponse, error)
+	GetBerryList(ctx context.Context, limit, offset int, flavor, firmness, baseUrl string) (model.BerryListResponse, error)
+	GetBerryFlavor(ctx context.Context, identifier string) (model.BerryFlavorResponse, error)
+	GetBerryFlavorList(ctx context.Context, limit, offset int, baseUrl string) (model.BerryFlavorListResponse, error)
+}
+
+// berryServiceImpl implements the BerryService interface.
+type berryServiceImpl struct {
+	berryRepo     repository.BerryRepository
+	pokeAPIClient *pokeapi.Client
+}
+
+// NewBerryService creates a new instance of BerryService.
+func NewBerryService(repo repository.BerryRepository, api *pokeapi.Client) BerryService {
+	return &berryServiceImpl{
+		berryRepo:     repo,
+		pokeAPIClient: api,
+	}
+}
+
+// SyncAllBerries fetches all berries from PokeAPI and saves them to the repository.
+func (s *berryServiceImpl) SyncAllBerries(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.berryResource())
+}
+
+// SyncAllBerryFlavors fetches all berry flavors from PokeAPI and saves them to the repository.
+func (s *berryServiceImpl) SyncAllBerryFlavors(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.berryFlavorResource())
+}
+
+// SyncBerry fetches a single berry by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *berryServiceImpl) SyncBerry(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.berryResource(), url)
+}
+
+// SyncBerryFlavor fetches a single berry flavor by its PokeAPI URL and saves it.
+func (s *berryServiceImpl) SyncBerryFlavor(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.berryFlavorResource(), url)
+}
+
+func (s *berryServiceImpl) berryResource() syncer.Resource[model.BerryDetail] {
+	return syncer.Resource[model.BerryDetail]{
+		Name:         "berry",
+		Endpoint:     "berry",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchBerryDetail,
+		Save:         s.berryRepo.SaveBerry,
+		LastSyncedAt: s.berryRepo.GetBerryLastSyncedAt,
+		ActiveNames:  s.berryRepo.GetBerryActiveNames,
+		Reconcile:    s.berryRepo.ReconcileBerryTombstones,
+	}
+}
+
+func (s *berryServiceImpl) berryFlavorResource() syncer.Resource[model.BerryFlavorDetail] {
+	return syncer.Resource[model.BerryFlavorDetail]{
+		Name:         "berry-flavor",
+		Endpoint:     "berry-flavor",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchBerryFlavorDetail,
+		Save:         s.berryRepo.SaveBerryFlavor,
+		LastSyncedAt: s.berryRepo.GetBerryFlavorLastSyncedAt,
+		ActiveNames:  s.berryRepo.GetBerryFlavorActiveNames,
+		Reconcile:    s.berryRepo.ReconcileBerryFlavorTombstones,
+	}
+}
+
+// GetBerry retrieves a berry by ID or name from the repository.
+func (s *berryServiceImpl) GetBerry(ctx context.Context, identifier string) (model.BerryResponse, error) {
+	var berry model.BerryDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		berry, err = s.berryRepo.GetBerryByID(ctx, id)
+	} else {
+		berry, err = s.berryRepo.GetBerryByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.BerryResponse{}, err
+	}
+
+	return toBerryResponse(berry), nil
+}
+
+func (s *berryServiceImpl) GetBerryList(ctx context.Context, limit, offset int, flavor, firmness, baseUrl string) (model.BerryListResponse, error) {
+	berries, totalCount, err := s.berryRepo.GetBerryList(ctx, limit, offset, flavor, firmness)
+	if err != nil {
+		return model.BerryListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	results := make([]model.BerryResponse, 0, len(berries))
+	for _, b := range berries {
+		results = append(results, toBerryResponse(b))
+	}
+
+	// Filter flavor dan firmness ikut dibawa ke URL next dan previous
+	filterQuery := ""
+	if flavor != "" {
+		filterQuery += "&flavor=" + url.QueryEscape(flavor)
+	}
+	if firmness != "" {
+		filterQuery += "&firmness=" + url.QueryEscape(firmness)
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d%s", baseUrl, limit, offset+limit, filterQuery)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d%s", baseUrl, limit, prevOffset, filterQuery)
+		previousURL = &url
+	}
+
+	return model.BerryListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  results,
+	}, nil
+}
+
+// GetBerryFlavor retrieves a berry flavor by ID or name from the repository.
+func (s *berryServiceImpl) GetBerryFlavor(ctx context.Context, identifier string) (model.BerryFlavorResponse, error) {
+	var flavor model.BerryFlavorDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		flavor, err = s.berryRepo.GetBerryFlavorByID(ctx, id)
+	} else {
+		flavor, err = s.berryRepo.GetBerryFlavorByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.BerryFlavorResponse{}, err
+	}
+
+	return toBerryFlavorResponse(flavor), nil
+}
+
+func (s *berryServiceImpl) GetBerryFlavorList(ctx context.Context, limit, offset int, baseUrl string) (model.BerryFlavorListResponse, error) {
+	flavors, totalCount, err := s.berryRepo.GetBerryFlavorList(ctx, limit, offset)
+	if err != nil {
+		return model.BerryFlavorListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	results := make([]model.BerryFlavorResponse, 0, len(flavors))
+	for _, f := range flavors {
+		results = append(results, toBerryFlavorResponse(f))
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.BerryFlavorListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  results,
+	}, nil
+}
+
+// toBerryResponse flattens the references of a berry into the API response.
+func toBerryResponse(berry model.BerryDetail) model.BerryResponse {
+	res := model.BerryResponse{
+		ID:               berry.ID,
+		Name:             berry.Name,
+		Item:             berry.Item.Name,
+		GrowthTime:       berry.GrowthTime,
+		MaxHarvest:       berry.MaxHarvest,
+		NaturalGiftPower: berry.NaturalGiftPower,
+		NaturalGiftType:  berry.NaturalGiftType.Name,
+		Size:             berry.Size,
+		Smoothness:       berry.Smoothness,
+		SoilDryness:      berry.SoilDryness,
+		Firmness:         berry.Firmness.Name,
+		Flavors:          make([]model.BerryFlavorPotency, 0, len(berry.Flavors)),
+	}
+	for _, f := range berry.Flavors {
+		res.Flavors = append(res.Flavors, model.BerryFlavorPotency{
+			Flavor:  f.Flavor.Name,
+			Potency: f.Potency,
+		})
+	}
+	return res
+}
+
+// toBerryFlavorResponse lists the berries of a flavor from the strongest potency
+// down, leaving out berries that do not carry the flavor at all.
+func toBerryFlavorResponse(flavor model.BerryFlavorDetail) model.BerryFlavorResponse {
+	res := model.BerryFlavorResponse{
+		ID:          flavor.ID,
+		Name:        flavor.Name,
+		ContestType: flavor.ContestType.Name,
+		DisplayName: utils.EnglishText(flavor.Names),
+		Berries:     make([]model.FlavorBerryPotency, 0, len(flavor.Berries)),
+	}
+	for _, b := range flavor.Berries {
+		if b.Potency > 0 {
+			res.Berries = append(res.Berries, model.FlavorBerryPotency{
+				Berry:   b.Berry.Name,
+				Potency: b.Potency,
+			})
+		}
+	}
+	sort.SliceStable(res.Berries, func(i, j int) bool {
+		return res.Berries[i].Potency > res.Berries[j].Potency
+	})
+	return res
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/egg-group/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EggGroupHandler struct {
+	eggGroupService service.EggGroupService
+}
+
+func NewEggGroupHandler(svc service.EggGroupService) *EggGroupHandler {
+	return &EggGroupHandler{
+		eggGroupService: svc,
+	}
+}
+
+func (h *EggGroupHandler) GetEggGroupList(c *gin.Context) {
+	limitStr := c.DefaultQuery("limit", "20")
+	offsetStr := c.DefaultQuery("offset", "0")
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	// Mendapatkan skema (http/https), host, dan path dasar dari request
+	scheme := "http"
+	if c.Request.TLS != nil { // Cek apakah koneksi menggunakan HTTPS
+		scheme = "https"
+	}
+	baseUrl := fmt.Sprintf("%s://%s/api/v1/egg-group", scheme, c.Request.Host)
+
+	listResponse, err := h.eggGroupService.GetEggGroupList(ctx, limit, offset, baseUrl)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve egg group list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *EggGroupHandler) GetEggGroupDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	eggGroup, err := h.eggGroupService.GetEggGroup(ctx, identifier)
+	if err != nil {
+		// More robust error checking for "not found"
+		if err.Error() == fmt.Sprintf("egg group not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve egg group detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, eggGroup)
+}
+
+// CheckBreedingCompatibility handles GET /api/v1/breeding/compatible?a=...&b=...
+func (h *EggGroupHandler) CheckBreedingCompatibility(c *gin.Context) {
+	a := c.Query("a")
+	b := c.Query("b")
+	if a == "" || b == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "query parameters 'a' and 'b' are required"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	result, err := h.eggGroupService.CheckBreedingCompatibility(ctx, a, b)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "pokemon species not found: ") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check breeding compatibility"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// FindEggMoveChains handles GET /api/v1/breeding/egg-move-chain?target=...&move=...&version_group=...
+func (h *EggGroupHandler) FindEggMoveChains(c *gin.Context) {
+	target := c.Query("target")
+	move := c.Query("move")
+	if target == "" || move == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "query parameters 'target' and 'move' are required"})
+		return
+	}
+	versionGroup := strings.ToLower(c.Query("version_group"))
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	result, err := h.eggGroupService.FindEggMoveChains(ctx, target, move, versionGroup)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "pokemon species not found: ") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "move not learnable: ") || strings.HasPrefix(err.Error(), "version group not found: ") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to find egg move chains"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
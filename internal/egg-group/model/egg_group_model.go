@@ -0,0 +1,156 @@
+package model
+
+import (
+	"pokedex/utils"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+func (n NameEntry) LanguageName() string  { return n.Language.Name }
+func (n NameEntry) LocalizedText() string { return n.Name }
+
+// EggGroupDetail is an egg group as returned by PokeAPI /egg-group/{id}.
+type EggGroupDetail struct {
+	ID             int                 `json:"id" bson:"id"`
+	Name           string              `json:"name" bson:"name"`
+	Names          []NameEntry         `json:"names" bson:"names"`
+	PokemonSpecies []ResourceReference `json:"pokemon_species" bson:"pokemon_species"`
+}
+
+// EggGroupSpecies is one member species of an egg group.
+type EggGroupSpecies struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+// EggGroupResponse is what /api/v1/egg-group/:identifier returns.
+type EggGroupResponse struct {
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	DisplayName  string            `json:"display_name"`
+	SpeciesCount int               `json:"species_count"`
+	Species      []EggGroupSpecies `json:"species"`
+}
+
+// EggGroupListItem is one egg group in the list, without its members.
+type EggGroupListItem struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"display_name"`
+	SpeciesCount int    `json:"species_count"`
+}
+
+// EggGroupListResponse
+type EggGroupListResponse struct {
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []EggGroupListItem `json:"results"`
+}
+
+// EggGroupDocument is the structure to store in MongoDB
+type EggGroupDocument struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty"`
+	EggGroupID     int                 `bson:"id"`
+	Name           string              `bson:"name"`
+	Names          []NameEntry         `bson:"names"`
+	PokemonSpecies []ResourceReference `bson:"pokemon_species"`
+	LastSyncedAt   int64               `bson:"last_synced_at"`
+}
+
+// BreedingSpecies holds the fields of a stored pokemon species that breeding rules need.
+type BreedingSpecies struct {
+	ID                 int                 `bson:"id"`
+	Name               string              `bson:"name"`
+	EggGroups          []ResourceReference `bson:"egg_groups"`
+	GenderRate         int                 `bson:"gender_rate"`
+	IsBaby             bool                `bson:"is_baby"`
+	EvolvesFromSpecies *ResourceReference  `bson:"evolves_from_species"`
+	EvolutionChain     ResourceReference   `bson:"evolution_chain"`
+}
+
+// BreedingParent describes one of the two pokemon passed to /api/v1/breeding/compatible.
+type BreedingParent struct {
+	ID           int                            `json:"id"`
+	Name         string                         `json:"name"`
+	EggGroups    []string                       `json:"egg_groups"`
+	GenderRate   utils.GenderDistributionResult `json:"gender_rate"`
+	IsGenderless bool                           `json:"is_genderless"`
+	Thumbnail    string                         `json:"thumbnail"`
+}
+
+// BreedingOffspring is a species the egg can hatch into. Mother is the parent
+// whose species decides the egg; Incense is set when that species only hatches
+// while the parent holds the incense.
+type BreedingOffspring struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Thumbnail string `json:"thumbnail"`
+	Mother    string `json:"mother"`
+	Incense   string `json:"incense,omitempty"`
+}
+
+// BreedingCompatibilityResponse is what /api/v1/breeding/compatible returns.
+type BreedingCompatibilityResponse struct {
+	ParentA         BreedingParent      `json:"parent_a"`
+	ParentB         BreedingParent      `json:"parent_b"`
+	Compatible      bool                `json:"compatible"`
+	Reason          string              `json:"reason"`
+	SharedEggGroups []string            `json:"shared_egg_groups"`
+	Offspring       []BreedingOffspring `json:"offspring"`
+}
+
+// MoveLearner is a default pokemon that learns one move, with only that move's
+// learn details loaded.
+type MoveLearner struct {
+	ID      int               `bson:"id"`
+	Name    string            `bson:"name"`
+	Species ResourceReference `bson:"species"`
+	Moves   []struct {
+		Move                ResourceReference `bson:"move"`
+		VersionGroupDetails []MoveLearnDetail `bson:"version_group_details"`
+	} `bson:"moves"`
+}
+
+type MoveLearnDetail struct {
+	LevelLearnedAt  int               `bson:"level_learned_at"`
+	MoveLearnMethod ResourceReference `bson:"move_learn_method"`
+	VersionGroup    ResourceReference `bson:"version_group"`
+}
+
+// EggMoveChainStep is one parent in a breeding chain. The first step learns the
+// move by level-up; every later step hatches with it as an egg move, bred from
+// the previous step through EggGroup.
+type EggMoveChainStep struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Thumbnail string `json:"thumbnail"`
+	Method    string `json:"method"`
+	Level     int    `json:"level,omitempty"`
+	EggGroup  string `json:"egg_group,omitempty"`
+}
+
+type EggMoveChain struct {
+	Length int                `json:"length"`
+	Steps  []EggMoveChainStep `json:"steps"`
+}
+
+// EggMoveChainResponse is what /api/v1/breeding/egg-move-chain returns.
+type EggMoveChainResponse struct {
+	Target       string         `json:"target"`
+	Move         string         `json:"move"`
+	VersionGroup string         `json:"version_group,omitempty"`
+	Chains       []EggMoveChain `json:"chains"`
+}
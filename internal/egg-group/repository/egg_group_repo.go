@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/egg-group/model"
+	generation_repo "pokedex/internal/generation/repository"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	eggGroupCollectionName       = "egg_groups"
+	pokemonCollectionName        = "pokemons"
+	pokemonSpeciesCollectionName = "pokemon-species"
+	evolutionCollectionName      = "evolutions"
+)
+
+// EggGroupRepository defines the interface for persisting and retrieving egg groups
+// and the species and evolution data that breeding checks read.
+type EggGroupRepository interface {
+	SaveEggGroup(ctx context.Context, eggGroup model.EggGroupDetail) error
+	GetEggGroupByID(ctx context.Context, id int) (model.EggGroupDetail, error)
+	GetEggGroupByName(ctx context.Context, name string) (model.EggGroupDetail, error)
+	GetEggGroupList(ctx context.Context, limit, offset int) ([]model.EggGroupDetail, int64, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+
+	GetBreedingSpeciesByID(ctx context.Context, id int) (model.BreedingSpecies, error)
+	GetBreedingSpeciesByName(ctx context.Context, name string) (model.BreedingSpecies, error)
+	GetBreedingSpeciesByNames(ctx context.Context, names []string) (map[string]model.BreedingSpecies, error)
+	GetMoveLearners(ctx context.Context, move string) ([]model.MoveLearner, error)
+	GetBabyTriggerItem(ctx context.Context, chainID int) (string, error)
+	GetVersionGroupOrder(ctx context.Context) (map[string]int, error)
+}
+
+// MongoEggGroupRepository implements the EggGroupRepository interface for MongoDB.
+type MongoEggGroupRepository struct {
+	collection             *mongo.Collection
+	pokemonCollection      *mongo.Collection
+	speciesCollection      *mongo.Collection
+	evolutionCollection    *mongo.Collection
+	versionGroupCollection *mongo.Collection
+}
+
+// NewMongoEggGroupRepository creates a new MongoDB repository for egg groups.
+func NewMongoEggGroupRepository() *MongoEggGroupRepository {
+	return &MongoEggGroupRepository{
+		collection:             database.MongoDatabase.Collection(eggGroupCollectionName),
+		pokemonCollection:      database.MongoDatabase.Collection(pokemonCollectionName),
+		speciesCollection:      database.MongoDatabase.Collection(pokemonSpeciesCollectionName),
+		evolutionCollection:    database.MongoDatabase.Collection(evolutionCollectionName),
+		versionGroupCollection: database.MongoDatabase.Collection(generation_repo.VersionGroupCollectionName),
+	}
+}
+
+// SaveEggGroup saves an egg group to MongoDB, upserting on 'id'.
+func (r *MongoEggGroupRepository) SaveEggGroup(ctx context.Context, eggGroup model.EggGroupDetail) error {
+	doc := model.EggGroupDocument{
+		EggGroupID:     eggGroup.ID,
+		Name:           eggGroup.Name,
+		Names:          eggGroup.Names,
+		PokemonSpecies: eggGroup.PokemonSpecies,
+		LastSyncedAt:   time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.EggGroupID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save egg group %s (ID: %d) to MongoDB: %w", eggGroup.Name, eggGroup.ID, err)
+	}
+	return nil
+}
+
+// GetEggGroupByID retrieves an egg group by its original PokeAPI ID from MongoDB.
+func (r *MongoEggGroupRepository) GetEggGroupByID(ctx context.Context, id int) (model.EggGroupDetail, error) {
+	var doc model.EggGroupDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.EggGroupDetail{}, fmt.Errorf("egg group not found: %d", id)
+		}
+		return model.EggGroupDetail{}, fmt.Errorf("failed to retrieve egg group by ID from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetEggGroupByName retrieves an egg group by its name (e.g. "monster") from MongoDB.
+func (r *MongoEggGroupRepository) GetEggGroupByName(ctx context.Context, name string) (model.EggGroupDetail, error) {
+	var doc model.EggGroupDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.EggGroupDetail{}, fmt.Errorf("egg group not found: %s", name)
+		}
+		return model.EggGroupDetail{}, fmt.Errorf("failed to retrieve egg group by name from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetEggGroupList returns a page of egg groups sorted by ID.
+func (r *MongoEggGroupRepository) GetEggGroupList(ctx context.Context, limit, offset int) ([]model.EggGroupDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count egg groups in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual egg group ID
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve egg group list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var eggGroupDocs []model.EggGroupDocument
+	if err = cursor.All(ctx, &eggGroupDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode egg group list from DB: %w", err)
+	}
+
+	var eggGroups []model.EggGroupDetail
+	for _, doc := range eggGroupDocs {
+		eggGroups = append(eggGroups, r.toDetail(doc))
+	}
+
+	return eggGroups, totalCount, nil
+}
+
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored egg group whose ID is in ids.
+func (r *MongoEggGroupRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every egg group that is not tombstoned.
+func (r *MongoEggGroupRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every egg group whose ID was not seen by a full sync.
+func (r *MongoEggGroupRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// GetBreedingSpeciesByID retrieves the breeding fields of a pokemon species by its PokeAPI ID.
+func (r *MongoEggGroupRepository) GetBreedingSpeciesByID(ctx context.Context, id int) (model.BreedingSpecies, error) {
+	species, err := r.findBreedingSpecies(ctx, bson.M{"id": id})
+	if err == mongo.ErrNoDocuments {
+		return model.BreedingSpecies{}, fmt.Errorf("pokemon species not found: %d", id)
+	}
+	return species, err
+}
+
+// GetBreedingSpeciesByName retrieves the breeding fields of a pokemon species by its name.
+func (r *MongoEggGroupRepository) GetBreedingSpeciesByName(ctx context.Context, name string) (model.BreedingSpecies, error) {
+	species, err := r.findBreedingSpecies(ctx, bson.M{"name": name})
+	if err == mongo.ErrNoDocuments {
+		return model.BreedingSpecies{}, fmt.Errorf("pokemon species not found: %s", name)
+	}
+	return species, err
+}
+
+// GetBreedingSpeciesByNames retrieves the breeding fields of every stored species whose name is in names, keyed by name.
+func (r *MongoEggGroupRepository) GetBreedingSpeciesByNames(ctx context.Context, names []string) (map[string]model.BreedingSpecies, error) {
+	result := make(map[string]model.BreedingSpecies)
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	findOptions := options.Find().SetProjection(breedingSpeciesProjection)
+	cursor, err := r.speciesCollection.Find(ctx, bson.M{"name": bson.M{"$in": names}}, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve pokemon species from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []model.BreedingSpecies
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode pokemon species from DB: %w", err)
+	}
+
+	for _, doc := range docs {
+		result[doc.Name] = doc
+	}
+	return result, nil
+}
+
+// GetMoveLearners returns every default pokemon that learns move in any way,
+// loading only that move's entry from each pokemon's move list.
+func (r *MongoEggGroupRepository) GetMoveLearners(ctx context.Context, move string) ([]model.MoveLearner, error) {
+	filter := tombstone.Active(bson.M{"is_default": true, "moves.move.name": move})
+	findOptions := options.Find().SetProjection(bson.M{
+		"id":      1,
+		"name":    1,
+		"species": 1,
+		"moves":   bson.M{"$elemMatch": bson.M{"move.name": move}},
+	})
+
+	cursor, err := r.pokemonCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve learners of move %s from DB: %w", move, err)
+	}
+	defer cursor.Close(ctx)
+
+	var learners []model.MoveLearner
+	if err = cursor.All(ctx, &learners); err != nil {
+		return nil, fmt.Errorf("failed to decode learners of move %s from DB: %w", move, err)
+	}
+	return learners, nil
+}
+
+// breedingSpeciesProjection loads only the species fields that model.BreedingSpecies holds.
+var breedingSpeciesProjection = bson.M{
+	"id":                   1,
+	"name":                 1,
+	"egg_groups":           1,
+	"gender_rate":          1,
+	"is_baby":              1,
+	"evolves_from_species": 1,
+	"evolution_chain":      1,
+}
+
+func (r *MongoEggGroupRepository) findBreedingSpecies(ctx context.Context, filter bson.M) (model.BreedingSpecies, error) {
+	var species model.BreedingSpecies
+	findOptions := options.FindOne().SetProjection(breedingSpeciesProjection)
+
+	err := r.speciesCollection.FindOne(ctx, filter, findOptions).Decode(&species)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return model.BreedingSpecies{}, fmt.Errorf("failed to retrieve pokemon species from DB: %w", err)
+	}
+	return species, err
+}
+
+// GetBabyTriggerItem returns the incense a parent must hold for its egg to hatch
+// into the baby of an evolution chain, or "" when the chain has none (or is not synced).
+func (r *MongoEggGroupRepository) GetBabyTriggerItem(ctx context.Context, chainID int) (string, error) {
+	var doc struct {
+		BabyTriggerItem *model.ResourceReference `bson:"baby_trigger_item"`
+	}
+
+	findOptions := options.FindOne().SetProjection(bson.M{"baby_trigger_item": 1})
+	err := r.evolutionCollection.FindOne(ctx, bson.M{"id": chainID}, findOptions).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to retrieve evolution chain from DB: %w", err)
+	}
+	if doc.BabyTriggerItem == nil {
+		return "", nil
+	}
+	return doc.BabyTriggerItem.Name, nil
+}
+
+// toDetail converts an EggGroupDocument to a model.EggGroupDetail.
+func (r *MongoEggGroupRepository) toDetail(doc model.EggGroupDocument) model.EggGroupDetail {
+	return model.EggGroupDetail{
+		ID:             doc.EggGroupID,
+		Name:           doc.Name,
+		Names:          doc.Names,
+		PokemonSpecies: doc.PokemonSpecies,
+	}
+}
+
+// GetVersionGroupOrder returns the release order of every synced version group, keyed by name.
+// It is empty until the version-group sync has run.
+func (r *MongoEggGroupRepository) GetVersionGroupOrder(ctx context.Context) (map[string]int, error) {
+	return generation_repo.VersionGroupOrder(ctx, r.versionGroupCollection)
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pokedex/internal/egg-group/model"
+	"pokedex/internal/shared/spritemirror"
+	"pokedex/utils"
+	"sort"
+	"strings"
+)
+
+const (
+	learnMethodLevelUp = "level-up"
+	learnMethodEgg     = "egg"
+
+	// Batas jumlah rantai terpendek yang dikembalikan
+	maxEggMoveChains = 10
+)
+
+// moveLearnInfo is how one species learns the searched move.
+type moveLearnInfo struct {
+	pokemonID int
+	levelUp   bool
+	level     int
+	egg       bool
+}
+
+// FindEggMoveChains searches the shortest breeding chains that carry move from a
+// species learning it by level-up to target, which must learn it as an egg move.
+// Each hop breeds a father that knows the move with a mother of the next species
+// in a shared egg group; the hatched offspring then knows the move.
+// When versionGroup is empty, learnsets of every version group are combined.
+func (s *eggGroupServiceImpl) FindEggMoveChains(ctx context.Context, target, move, versionGroup string) (model.EggMoveChainResponse, error) {
+	targetSpecies, err := s.getBreedingSpecies(ctx, target)
+	if err != nil {
+		return model.EggMoveChainResponse{}, err
+	}
+	move = strings.ToLower(move)
+
+	if versionGroup != "" {
+		versionGroupOrder, err := s.eggGroupRepo.GetVersionGroupOrder(ctx)
+		if err != nil {
+			return model.EggMoveChainResponse{}, err
+		}
+		if _, ok := versionGroupOrder[versionGroup]; !ok {
+			return model.EggMoveChainResponse{}, fmt.Errorf("version group not found: %s", versionGroup)
+		}
+	}
+
+	learners, err := s.eggGroupRepo.GetMoveLearners(ctx, move)
+	if err != nil {
+		return model.EggMoveChainResponse{}, err
+	}
+	learnInfo := collectLearnInfo(learners, move, versionGroup)
+
+	names := make([]string, 0, len(learnInfo))
+	for name := range learnInfo {
+		names = append(names, name)
+	}
+	species, err := s.eggGroupRepo.GetBreedingSpeciesByNames(ctx, names)
+	if err != nil {
+		return model.EggMoveChainResponse{}, err
+	}
+
+	res := model.EggMoveChainResponse{
+		Target:       targetSpecies.Name,
+		Move:         move,
+		VersionGroup: versionGroup,
+		Chains:       make([]model.EggMoveChain, 0),
+	}
+
+	targetInfo, ok := learnInfo[targetSpecies.Name]
+	if !ok {
+		return model.EggMoveChainResponse{}, fmt.Errorf("move not learnable: %s cannot learn %s by level-up or as an egg move", targetSpecies.Name, move)
+	}
+	if targetInfo.levelUp {
+		// Target sudah mempelajari move lewat level-up, tidak perlu breeding
+		res.Chains = append(res.Chains, model.EggMoveChain{
+			Length: 1,
+			Steps:  []model.EggMoveChainStep{toChainStep(targetSpecies, targetInfo, learnMethodLevelUp, "")},
+		})
+		return res, nil
+	}
+
+	preds := shortestBreedingPaths(species, learnInfo, targetSpecies.Name)
+	for _, path := range enumeratePaths(preds, targetSpecies.Name, maxEggMoveChains) {
+		chain := model.EggMoveChain{Length: len(path)}
+		for i, name := range path {
+			if i == 0 {
+				chain.Steps = append(chain.Steps, toChainStep(species[name], learnInfo[name], learnMethodLevelUp, ""))
+				continue
+			}
+			// Egg group pertama yang sama dengan induk jantan sebelumnya
+			eggGroup := sharedEggGroups(species[path[i-1]], species[name])[0]
+			chain.Steps = append(chain.Steps, toChainStep(species[name], learnInfo[name], learnMethodEgg, eggGroup))
+		}
+		res.Chains = append(res.Chains, chain)
+	}
+	return res, nil
+}
+
+// collectLearnInfo records, per species, whether its default pokemon learns move
+// by level-up (at the lowest level) or as an egg move in versionGroup.
+func collectLearnInfo(learners []model.MoveLearner, move, versionGroup string) map[string]moveLearnInfo {
+	learnInfo := make(map[string]moveLearnInfo, len(learners))
+	for _, learner := range learners {
+		info := moveLearnInfo{pokemonID: learner.ID}
+		for _, m := range learner.Moves {
+			if m.Move.Name != move {
+				continue
+			}
+			for _, d := range m.VersionGroupDetails {
+				if versionGroup != "" && d.VersionGroup.Name != versionGroup {
+					continue
+				}
+				switch d.MoveLearnMethod.Name {
+				case learnMethodLevelUp:
+					if !info.levelUp || d.LevelLearnedAt < info.level {
+						info.level = d.LevelLearnedAt
+					}
+					info.levelUp = true
+				case learnMethodEgg:
+					info.egg = true
+				}
+			}
+		}
+		if info.levelUp || info.egg {
+			learnInfo[learner.Species.Name] = info
+		}
+	}
+	return learnInfo
+}
+
+// shortestBreedingPaths runs a breadth-first search from every level-up learner
+// that can be a father and returns, for each species reached, the species one
+// hop closer to a level-up learner on a shortest path.
+func shortestBreedingPaths(species map[string]model.BreedingSpecies, learnInfo map[string]moveLearnInfo, target string) map[string][]string {
+	nodes := make([]string, 0, len(species))
+	for name := range species {
+		if !inEggGroup(species[name], eggGroupUndiscovered) {
+			nodes = append(nodes, name)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return species[nodes[i]].ID < species[nodes[j]].ID
+	})
+
+	dist := make(map[string]int)
+	preds := make(map[string][]string)
+	var queue []string
+	for _, name := range nodes {
+		if learnInfo[name].levelUp && canBeMale(species[name]) {
+			dist[name] = 0
+			queue = append(queue, name)
+		}
+	}
+
+	for len(queue) > 0 {
+		father := queue[0]
+		queue = queue[1:]
+		if _, found := dist[target]; found && dist[father] >= dist[target] {
+			break
+		}
+
+		for _, child := range nodes {
+			// Anak harus bisa betina (spesies telur mengikuti induk betina), dan
+			// selain target juga harus bisa jantan agar move bisa diwariskan lagi
+			if child == father || !learnInfo[child].egg || !canBeFemale(species[child]) {
+				continue
+			}
+			if child != target && !canBeMale(species[child]) {
+				continue
+			}
+			if len(sharedEggGroups(species[father], species[child])) == 0 {
+				continue
+			}
+
+			d, seen := dist[child]
+			if !seen {
+				dist[child] = dist[father] + 1
+				preds[child] = []string{father}
+				queue = append(queue, child)
+			} else if d == dist[father]+1 {
+				preds[child] = append(preds[child], father)
+			}
+		}
+	}
+	return preds
+}
+
+// enumeratePaths walks preds back from target and returns up to limit paths,
+// each ordered from the level-up learner to target.
+func enumeratePaths(preds map[string][]string, target string, limit int) [][]string {
+	var paths [][]string
+	var walk func(node string, suffix []string)
+	walk = func(node string, suffix []string) {
+		if len(paths) >= limit {
+			return
+		}
+		path := append([]string{node}, suffix...)
+		if len(preds[node]) == 0 {
+			paths = append(paths, path)
+			return
+		}
+		for _, prev := range preds[node] {
+			walk(prev, path)
+		}
+	}
+
+	if len(preds[target]) > 0 {
+		walk(target, nil)
+	}
+	return paths
+}
+
+func toChainStep(species model.BreedingSpecies, info moveLearnInfo, method, eggGroup string) model.EggMoveChainStep {
+	step := model.EggMoveChainStep{
+		ID:        info.pokemonID,
+		Name:      species.Name,
+		Thumbnail: spritemirror.URL(utils.GetThumbnailPokemon(info.pokemonID)),
+		Method:    method,
+		EggGroup:  eggGroup,
+	}
+	if method == learnMethodLevelUp {
+		step.Level = info.level
+	}
+	return step
+}
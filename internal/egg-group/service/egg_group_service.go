@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pokedex/internal/egg-group/model"
+	"pokedex/internal/egg-group/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/spritemirror"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	eggGroupUndiscovered = "no-eggs"
+	eggGroupDitto        = "ditto"
+	genderlessRate       = -1
+)
+
+// Telur dari spesies ini menetas menjadi spesies lain, bukan bentuk dasar evolusinya
+var offspringOverride = map[string]string{
+	"manaphy": "phione",
+}
+
+// Telur dari spesies ini bisa juga menetas menjadi pasangan spesiesnya
+var extraOffspring = map[string]string{
+	"nidoran-f": "nidoran-m",
+	"illumise":  "volbeat",
+}
+
+// Dengan Ditto, telur dari spesies jantan ini juga bisa menetas menjadi pasangan betinanya
+var dittoExtraOffspring = map[string]string{
+	"nidoran-m": "nidoran-f",
+	"volbeat":   "illumise",
+}
+
+// EggGroupService defines the business logic for egg groups and breeding checks.
+type EggGroupService interface {
+	SyncAllEggGroups(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncEggGroup(ctx context.Context, url string) error
+	GetEggGroup(ctx context.Context, identifier string) (model.EggGroupResponse, error)
+	GetEggGroupList(ctx context.Context, limit, offset int, baseUrl string) (model.EggGroupListResponse, error)
+	CheckBreedingCompatibility(ctx context.Context, a, b string) (model.BreedingCompatibilityResponse, error)
+	FindEggMoveChains(ctx context.Context, target, move, versionGroup string) (model.EggMoveChainResponse, error)
+}
+
+// eggGroupServiceImpl implements the EggGroupService interface.
+type eggGroupServiceImpl struct {
+	eggGroupRepo  repository.EggGroupRepository
+	pokeAPIClient *pokeapi.Client
+}
+
+// NewEggGroupService creates a new instance of EggGroupService.
+func NewEggGroupService(repo repository.EggGroupRepository, api *pokeapi.Client) EggGroupService {
+	return &eggGroupServiceImpl{
+		eggGroupRepo:  repo,
+		pokeAPIClient: api,
+	}
+}
+
+// SyncAllEggGroups fetches all egg groups from PokeAPI and saves them to the repository.
+func (s *eggGroupServiceImpl) SyncAllEggGroups(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
+
+// SyncEggGroup fetches a single egg group by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *eggGroupServiceImpl) SyncEggGroup(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
+
+// syncResource describes how egg groups are listed, fetched and stored by the sync engine.
+func (s *eggGroupServiceImpl) syncResource() syncer.Resource[model.EggGroupDetail] {
+	return syncer.Resource[model.EggGroupDetail]{
+		Name:         "egg-group",
+		Endpoint:     "egg-group",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchEggGroupDetail,
+		Save:         s.eggGroupRepo.SaveEggGroup,
+		LastSyncedAt: s.eggGroupRepo.GetLastSyncedAt,
+		ActiveNames:  s.eggGroupRepo.GetActiveNames,
+		Reconcile:    s.eggGroupRepo.ReconcileTombstones,
+	}
+}
+
+// GetEggGroup retrieves an egg group by ID or name, with its member species sorted by ID.
+func (s *eggGroupServiceImpl) GetEggGroup(ctx context.Context, identifier string) (model.EggGroupResponse, error) {
+	var eggGroup model.EggGroupDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		eggGroup, err = s.eggGroupRepo.GetEggGroupByID(ctx, id)
+	} else {
+		eggGroup, err = s.eggGroupRepo.GetEggGroupByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.EggGroupResponse{}, err
+	}
+
+	species := make([]model.EggGroupSpecies, 0, len(eggGroup.PokemonSpecies))
+	for _, ref := range eggGroup.PokemonSpecies {
+		speciesID := utils.ExtractIDFromURL(ref.URL)
+		species = append(species, model.EggGroupSpecies{
+			ID:        speciesID,
+			Name:      ref.Name,
+			Thumbnail: spritemirror.URL(utils.GetThumbnailPokemon(speciesID)),
+		})
+	}
+	sort.Slice(species, func(i, j int) bool {
+		return species[i].ID < species[j].ID
+	})
+
+	return model.EggGroupResponse{
+		ID:           eggGroup.ID,
+		Name:         eggGroup.Name,
+		DisplayName:  utils.EnglishText(eggGroup.Names),
+		SpeciesCount: len(species),
+		Species:      species,
+	}, nil
+}
+
+func (s *eggGroupServiceImpl) GetEggGroupList(ctx context.Context, limit, offset int, baseUrl string) (model.EggGroupListResponse, error) {
+	eggGroups, totalCount, err := s.eggGroupRepo.GetEggGroupList(ctx, limit, offset)
+	if err != nil {
+		return model.EggGroupListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	results := make([]model.EggGroupListItem, 0, len(eggGroups))
+	for _, eg := range eggGroups {
+		results = append(results, model.EggGroupListItem{
+			ID:           eg.ID,
+			Name:         eg.Name,
+			DisplayName:  utils.EnglishText(eg.Names),
+			SpeciesCount: len(eg.PokemonSpecies),
+		})
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.EggGroupListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  results,
+	}, nil
+}
+
+// CheckBreedingCompatibility decides whether species a and b can produce an egg
+// and, if so, which species the egg can hatch into.
+func (s *eggGroupServiceImpl) CheckBreedingCompatibility(ctx context.Context, a, b string) (model.BreedingCompatibilityResponse, error) {
+	parentA, err := s.getBreedingSpecies(ctx, a)
+	if err != nil {
+		return model.BreedingCompatibilityResponse{}, err
+	}
+	parentB, err := s.getBreedingSpecies(ctx, b)
+	if err != nil {
+		return model.BreedingCompatibilityResponse{}, err
+	}
+
+	res := model.BreedingCompatibilityResponse{
+		ParentA:         toBreedingParent(parentA),
+		ParentB:         toBreedingParent(parentB),
+		SharedEggGroups: sharedEggGroups(parentA, parentB),
+		Offspring:       make([]model.BreedingOffspring, 0),
+	}
+	res.Compatible, res.Reason = compatibility(parentA, parentB, res.SharedEggGroups)
+	if !res.Compatible {
+		return res, nil
+	}
+
+	withDitto := inEggGroup(parentA, eggGroupDitto) || inEggGroup(parentB, eggGroupDitto)
+	for _, mother := range mothers(parentA, parentB) {
+		offspring, err := s.hatchInto(ctx, mother, withDitto)
+		if err != nil {
+			return model.BreedingCompatibilityResponse{}, err
+		}
+		res.Offspring = append(res.Offspring, offspring...)
+	}
+	return res, nil
+}
+
+func (s *eggGroupServiceImpl) getBreedingSpecies(ctx context.Context, identifier string) (model.BreedingSpecies, error) {
+	id, err := strconv.Atoi(identifier)
+	if err == nil {
+		return s.eggGroupRepo.GetBreedingSpeciesByID(ctx, id)
+	}
+	return s.eggGroupRepo.GetBreedingSpeciesByName(ctx, strings.ToLower(identifier))
+}
+
+// hatchInto returns the species an egg from mother hatches into: the first stage
+// of its evolution line, or both the baby and the stage after it when the baby
+// needs an incense. When the other parent is Ditto, the male half of a gendered
+// species pair can also hatch its female counterpart.
+func (s *eggGroupServiceImpl) hatchInto(ctx context.Context, mother model.BreedingSpecies, withDitto bool) ([]model.BreedingOffspring, error) {
+	if name, ok := offspringOverride[mother.Name]; ok {
+		species, err := s.eggGroupRepo.GetBreedingSpeciesByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return []model.BreedingOffspring{toOffspring(species, mother, "")}, nil
+	}
+
+	// Telusuri evolves_from_species sampai ke bentuk paling dasar
+	lineage := []model.BreedingSpecies{mother}
+	for current := mother; current.EvolvesFromSpecies != nil; {
+		previous, err := s.eggGroupRepo.GetBreedingSpeciesByName(ctx, current.EvolvesFromSpecies.Name)
+		if err != nil {
+			return nil, err
+		}
+		lineage = append(lineage, previous)
+		current = previous
+	}
+
+	base := lineage[len(lineage)-1]
+	offspring := []model.BreedingOffspring{toOffspring(base, mother, "")}
+	if base.IsBaby && len(lineage) > 1 {
+		incense, err := s.eggGroupRepo.GetBabyTriggerItem(ctx, utils.ExtractIDFromURL(base.EvolutionChain.URL))
+		if err != nil {
+			return nil, err
+		}
+		// Tanpa incense, telur menetas menjadi tahap setelah bayi
+		if incense != "" {
+			offspring = []model.BreedingOffspring{
+				toOffspring(lineage[len(lineage)-2], mother, ""),
+				toOffspring(base, mother, incense),
+			}
+		}
+	}
+
+	name, ok := extraOffspring[mother.Name]
+	if !ok && withDitto {
+		name, ok = dittoExtraOffspring[mother.Name]
+	}
+	if ok {
+		species, err := s.eggGroupRepo.GetBreedingSpeciesByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		offspring = append(offspring, toOffspring(species, mother, ""))
+	}
+	return offspring, nil
+}
+
+// compatibility applies the breeding rules in order: the undiscovered group never
+// breeds, Ditto breeds with anything but another Ditto, genderless pokemon need
+// Ditto, and everything else needs a shared egg group and opposite genders.
+func compatibility(a, b model.BreedingSpecies, shared []string) (bool, string) {
+	for _, p := range []model.BreedingSpecies{a, b} {
+		if inEggGroup(p, eggGroupUndiscovered) {
+			return false, fmt.Sprintf("%s is in the undiscovered egg group and cannot breed", p.Name)
+		}
+	}
+
+	aDitto, bDitto := inEggGroup(a, eggGroupDitto), inEggGroup(b, eggGroupDitto)
+	switch {
+	case aDitto && bDitto:
+		return false, "two ditto cannot breed with each other"
+	case aDitto || bDitto:
+		return true, "ditto can breed with any pokemon outside the undiscovered egg group"
+	}
+
+	for _, p := range []model.BreedingSpecies{a, b} {
+		if p.GenderRate == genderlessRate {
+			return false, fmt.Sprintf("%s is genderless and can only breed with ditto", p.Name)
+		}
+	}
+
+	if len(shared) == 0 {
+		return false, fmt.Sprintf("%s and %s do not share an egg group", a.Name, b.Name)
+	}
+	if !(canBeMale(a) && canBeFemale(b)) && !(canBeMale(b) && canBeFemale(a)) {
+		return false, fmt.Sprintf("%s and %s cannot be of opposite genders", a.Name, b.Name)
+	}
+	return true, fmt.Sprintf("%s and %s share the %s egg group", a.Name, b.Name, strings.Join(shared, ", "))
+}
+
+// mothers returns the parents whose species the egg can take: the non-Ditto
+// parent when breeding with Ditto, otherwise every parent that can be female
+// while the other one is male.
+func mothers(a, b model.BreedingSpecies) []model.BreedingSpecies {
+	if inEggGroup(a, eggGroupDitto) {
+		return []model.BreedingSpecies{b}
+	}
+	if inEggGroup(b, eggGroupDitto) {
+		return []model.BreedingSpecies{a}
+	}
+
+	var result []model.BreedingSpecies
+	if canBeFemale(a) && canBeMale(b) {
+		result = append(result, a)
+	}
+	// Spesies yang sama tidak perlu dihitung dua kali
+	if canBeFemale(b) && canBeMale(a) && b.ID != a.ID {
+		result = append(result, b)
+	}
+	return result
+}
+
+// gender_rate is the chance of being female in eighths, or -1 for genderless.
+func canBeFemale(p model.BreedingSpecies) bool {
+	return p.GenderRate > 0
+}
+
+func canBeMale(p model.BreedingSpecies) bool {
+	return p.GenderRate >= 0 && p.GenderRate < 8
+}
+
+func inEggGroup(p model.BreedingSpecies, name string) bool {
+	for _, eg := range p.EggGroups {
+		if eg.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func sharedEggGroups(a, b model.BreedingSpecies) []string {
+	shared := make([]string, 0)
+	for _, eg := range a.EggGroups {
+		if inEggGroup(b, eg.Name) {
+			shared = append(shared, eg.Name)
+		}
+	}
+	return shared
+}
+
+func toBreedingParent(p model.BreedingSpecies) model.BreedingParent {
+	eggGroups := make([]string, 0, len(p.EggGroups))
+	for _, eg := range p.EggGroups {
+		eggGroups = append(eggGroups, eg.Name)
+	}
+	return model.BreedingParent{
+		ID:           p.ID,
+		Name:         p.Name,
+		EggGroups:    eggGroups,
+		GenderRate:   utils.CalcGenderDistribution(p.GenderRate),
+		IsGenderless: p.GenderRate == genderlessRate,
+		Thumbnail:    spritemirror.URL(utils.GetThumbnailPokemon(p.ID)),
+	}
+}
+
+func toOffspring(species, mother model.BreedingSpecies, incense string) model.BreedingOffspring {
+	return model.BreedingOffspring{
+		ID:        species.ID,
+		Name:      species.Name,
+		Thumbnail: spritemirror.URL(utils.GetThumbnailPokemon(species.ID)),
+		Mother:    mother.Name,
+		Incense:   incense,
+	}
+}
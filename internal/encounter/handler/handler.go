@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/encounter/service"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EncounterHandler struct {
+	encounterService service.EncounterService
+}
+
+func NewEncounterHandler(svc service.EncounterService) *EncounterHandler {
+	return &EncounterHandler{
+		encounterService: svc,
+	}
+}
+
+// GetPokemonEncounters handles GET /api/v1/pokemon/:identifier/encounters
+func (h *EncounterHandler) GetPokemonEncounters(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	encounters, err := h.encounterService.GetPokemonEncounters(ctx, identifier)
+	if err != nil {
+		if err.Error() == fmt.Sprintf("pokemon not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokemon encounters"})
+		return
+	}
+
+	c.JSON(http.StatusOK, encounters)
+}
@@ -0,0 +1,180 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+func (n NameEntry) LanguageName() string  { return n.Language.Name }
+func (n NameEntry) LocalizedText() string { return n.Name }
+
+// --- PokeAPI /pokemon/{id}/encounters ---
+
+// LocationAreaEncounter is one location area where a pokemon can be encountered.
+type LocationAreaEncounter struct {
+	LocationArea   ResourceReference        `json:"location_area" bson:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details" bson:"version_details"`
+}
+
+type VersionEncounterDetail struct {
+	Version          ResourceReference `json:"version" bson:"version"`
+	MaxChance        int               `json:"max_chance" bson:"max_chance"`
+	EncounterDetails []Encounter       `json:"encounter_details" bson:"encounter_details"`
+}
+
+type Encounter struct {
+	MinLevel        int                 `json:"min_level" bson:"min_level"`
+	MaxLevel        int                 `json:"max_level" bson:"max_level"`
+	ConditionValues []ResourceReference `json:"condition_values" bson:"condition_values"`
+	Chance          int                 `json:"chance" bson:"chance"`
+	Method          ResourceReference   `json:"method" bson:"method"`
+}
+
+// PokemonEncounters is the encounters sub-resource of a single pokemon.
+// PokeAPI returns a bare array, so the pokemon ID is taken from the URL.
+type PokemonEncounters struct {
+	PokemonID  int                     `json:"pokemon_id" bson:"id"`
+	Encounters []LocationAreaEncounter `json:"encounters" bson:"encounters"`
+}
+
+// --- PokeAPI /location, /location-area, /encounter-method, /encounter-condition ---
+
+type Location struct {
+	ID     int                 `json:"id" bson:"id"`
+	Name   string              `json:"name" bson:"name"`
+	Region *ResourceReference  `json:"region" bson:"region"`
+	Names  []NameEntry         `json:"names" bson:"names"`
+	Areas  []ResourceReference `json:"areas" bson:"areas"`
+}
+
+type LocationArea struct {
+	ID        int               `json:"id" bson:"id"`
+	Name      string            `json:"name" bson:"name"`
+	GameIndex int               `json:"game_index" bson:"game_index"`
+	Location  ResourceReference `json:"location" bson:"location"`
+	Names     []NameEntry       `json:"names" bson:"names"`
+}
+
+type EncounterMethod struct {
+	ID    int         `json:"id" bson:"id"`
+	Name  string      `json:"name" bson:"name"`
+	Order int         `json:"order" bson:"order"`
+	Names []NameEntry `json:"names" bson:"names"`
+}
+
+type EncounterCondition struct {
+	ID     int                 `json:"id" bson:"id"`
+	Name   string              `json:"name" bson:"name"`
+	Names  []NameEntry         `json:"names" bson:"names"`
+	Values []ResourceReference `json:"values" bson:"values"`
+}
+
+// --- Response ---
+
+// PokemonEncountersResponse is what /api/v1/pokemon/:identifier/encounters returns.
+type PokemonEncountersResponse struct {
+	PokemonID         int                    `json:"pokemon_id"`
+	PokemonName       string                 `json:"pokemon_name"`
+	Versions          []VersionEncounters    `json:"versions"`
+	PalParkEncounters []PalParkEncounterInfo `json:"pal_park_encounters"`
+}
+
+type VersionEncounters struct {
+	Version   string              `json:"version"`
+	Locations []LocationEncounter `json:"locations"`
+}
+
+type LocationEncounter struct {
+	LocationArea     string          `json:"location_area"`
+	LocationAreaName string          `json:"location_area_name"` // Kosong jika location area belum di-sync
+	Location         string          `json:"location"`
+	LocationName     string          `json:"location_name"`
+	Region           string          `json:"region"`
+	MaxChance        int             `json:"max_chance"`
+	Encounters       []EncounterInfo `json:"encounters"`
+}
+
+// EncounterInfo merges every encounter slot with the same method and conditions.
+type EncounterInfo struct {
+	Method     string                   `json:"method"`
+	MethodName string                   `json:"method_name"`
+	MinLevel   int                      `json:"min_level"`
+	MaxLevel   int                      `json:"max_level"`
+	Chance     int                      `json:"chance"` // Jumlah chance dari semua slot, dalam persen
+	Conditions []EncounterConditionInfo `json:"conditions"`
+}
+
+// EncounterConditionInfo is a condition value such as "time-morning" and the
+// condition it belongs to ("time"); Condition is empty until conditions are synced.
+type EncounterConditionInfo struct {
+	Condition string `json:"condition"`
+	Value     string `json:"value"`
+}
+
+type PalParkEncounterInfo struct {
+	Area      string `json:"area"`
+	BaseScore int    `json:"base_score"`
+	Rate      int    `json:"rate"`
+}
+
+// --- MongoDB documents ---
+
+type PokemonEncountersDocument struct {
+	ID           primitive.ObjectID      `bson:"_id,omitempty"`
+	PokemonID    int                     `bson:"id"`
+	Encounters   []LocationAreaEncounter `bson:"encounters"`
+	LastSyncedAt int64                   `bson:"last_synced_at"`
+}
+
+type LocationDocument struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty"`
+	LocationID   int                 `bson:"id"`
+	Name         string              `bson:"name"`
+	Region       *ResourceReference  `bson:"region"`
+	Names        []NameEntry         `bson:"names"`
+	Areas        []ResourceReference `bson:"areas"`
+	LastSyncedAt int64               `bson:"last_synced_at"`
+}
+
+type LocationAreaDocument struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	LocationAreaID int                `bson:"id"`
+	Name           string             `bson:"name"`
+	GameIndex      int                `bson:"game_index"`
+	Location       ResourceReference  `bson:"location"`
+	Names          []NameEntry        `bson:"names"`
+	LastSyncedAt   int64              `bson:"last_synced_at"`
+}
+
+type EncounterMethodDocument struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	MethodID     int                `bson:"id"`
+	Name         string             `bson:"name"`
+	Order        int                `bson:"order"`
+	Names        []NameEntry        `bson:"names"`
+	LastSyncedAt int64              `bson:"last_synced_at"`
+}
+
+type EncounterConditionDocument struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty"`
+	ConditionID  int                 `bson:"id"`
+	Name         string              `bson:"name"`
+	Names        []NameEntry         `bson:"names"`
+	Values       []ResourceReference `bson:"values"`
+	LastSyncedAt int64               `bson:"last_synced_at"`
+}
+
+// PokemonRef is the part of a stored pokemon needed to resolve its encounters.
+type PokemonRef struct {
+	ID      int               `bson:"id"`
+	Name    string            `bson:"name"`
+	Species ResourceReference `bson:"species"`
+}
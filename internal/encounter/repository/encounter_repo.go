@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/encounter/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	pokemonEncounterCollectionName   = "pokemon_encounters"
+	locationCollectionName           = "locations"
+	locationAreaCollectionName       = "location_areas"
+	encounterMethodCollectionName    = "encounter_methods"
+	encounterConditionCollectionName = "encounter_conditions"
+	pokemonCollectionName            = "pokemons"
+	pokemonSpeciesCollectionName     = "pokemon-species"
+)
+
+// EncounterRepository defines the interface for persisting and retrieving
+// wild encounters and the location and encounter reference data they point to.
+type EncounterRepository interface {
+	SavePokemonEncounters(ctx context.Context, encounters model.PokemonEncounters) error
+	SaveLocation(ctx context.Context, location model.Location) error
+	SaveLocationArea(ctx context.Context, area model.LocationArea) error
+	SaveEncounterMethod(ctx context.Context, method model.EncounterMethod) error
+	SaveEncounterCondition(ctx context.Context, condition model.EncounterCondition) error
+
+	GetPokemonEncountersLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetLocationLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetLocationAreaLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetEncounterMethodLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetEncounterConditionLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+
+	GetPokemonRef(ctx context.Context, id int, name string) (model.PokemonRef, error)
+	GetPokemonEncounters(ctx context.Context, pokemonID int) ([]model.LocationAreaEncounter, error)
+	GetLocationAreasByName(ctx context.Context, names []string) (map[string]model.LocationArea, error)
+	GetLocationsByName(ctx context.Context, names []string) (map[string]model.Location, error)
+	GetEncounterMethods(ctx context.Context) (map[string]model.EncounterMethod, error)
+	GetConditionByValue(ctx context.Context) (map[string]string, error)
+	GetPalParkEncounters(ctx context.Context, speciesName string) ([]model.PalParkEncounterInfo, error)
+}
+
+// MongoEncounterRepository implements the EncounterRepository interface for MongoDB.
+type MongoEncounterRepository struct {
+	collection          *mongo.Collection
+	locationCollection  *mongo.Collection
+	areaCollection      *mongo.Collection
+	methodCollection    *mongo.Collection
+	conditionCollection *mongo.Collection
+	pokemonCollection   *mongo.Collection
+	collectionSpecies   *mongo.Collection
+}
+
+// NewMongoEncounterRepository creates a new MongoDB repository for encounters.
+func NewMongoEncounterRepository() *MongoEncounterRepository {
+	return &MongoEncounterRepository{
+		collection:          database.MongoDatabase.Collection(pokemonEncounterCollectionName),
+		locationCollection:  database.MongoDatabase.Collection(locationCollectionName),
+		areaCollection:      database.MongoDatabase.Collection(locationAreaCollectionName),
+		methodCollection:    database.MongoDatabase.Collection(encounterMethodCollectionName),
+		conditionCollection: database.MongoDatabase.Collection(encounterConditionCollectionName),
+		pokemonCollection:   database.MongoDatabase.Collection(pokemonCollectionName),
+		collectionSpecies:   database.MongoDatabase.Collection(pokemonSpeciesCollectionName),
+	}
+}
+
+// SavePokemonEncounters saves the encounters of one pokemon, upserting on the pokemon 'id'.
+func (r *MongoEncounterRepository) SavePokemonEncounters(ctx context.Context, encounters model.PokemonEncounters) error {
+	doc := model.PokemonEncountersDocument{
+		PokemonID:    encounters.PokemonID,
+		Encounters:   encounters.Encounters,
+		LastSyncedAt: time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.collection, doc.PokemonID, doc); err != nil {
+		return fmt.Errorf("failed to save encounters of pokemon %d to MongoDB: %w", encounters.PokemonID, err)
+	}
+	return nil
+}
+
+// SaveLocation saves a location, upserting on 'id'.
+func (r *MongoEncounterRepository) SaveLocation(ctx context.Context, location model.Location) error {
+	doc := model.LocationDocument{
+		LocationID:   location.ID,
+		Name:         location.Name,
+		Region:       location.Region,
+		Names:        location.Names,
+		Areas:        location.Areas,
+		LastSyncedAt: time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.locationCollection, doc.LocationID, doc); err != nil {
+		return fmt.Errorf("failed to save location %s (ID: %d) to MongoDB: %w", location.Name, location.ID, err)
+	}
+	return nil
+}
+
+// SaveLocationArea saves a location area, upserting on 'id'.
+func (r *MongoEncounterRepository) SaveLocationArea(ctx context.Context, area model.LocationArea) error {
+	doc := model.LocationAreaDocument{
+		LocationAreaID: area.ID,
+		Name:           area.Name,
+		GameIndex:      area.GameIndex,
+		Location:       area.Location,
+		Names:          area.Names,
+		LastSyncedAt:   time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.areaCollection, doc.LocationAreaID, doc); err != nil {
+		return fmt.Errorf("failed to save location area %s (ID: %d) to MongoDB: %w", area.Name, area.ID, err)
+	}
+	return nil
+}
+
+// SaveEncounterMethod saves an encounter method, upserting on 'id'.
+func (r *MongoEncounterRepository) SaveEncounterMethod(ctx context.Context, method model.EncounterMethod) error {
+	doc := model.EncounterMethodDocument{
+		MethodID:     method.ID,
+		Name:         method.Name,
+		Order:        method.Order,
+		Names:        method.Names,
+		LastSyncedAt: time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.methodCollection, doc.MethodID, doc); err != nil {
+		return fmt.Errorf("failed to save encounter method %s (ID: %d) to MongoDB: %w", method.Name, method.ID, err)
+	}
+	return nil
+}
+
+// SaveEncounterCondition saves an encounter condition, upserting on 'id'.
+func (r *MongoEncounterRepository) SaveEncounterCondition(ctx context.Context, condition model.EncounterCondition) error {
+	doc := model.EncounterConditionDocument{
+		ConditionID:  condition.ID,
+		Name:         condition.Name,
+		Names:        condition.Names,
+		Values:       condition.Values,
+		LastSyncedAt: time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.conditionCollection, doc.ConditionID, doc); err != nil {
+		return fmt.Errorf("failed to save encounter condition %s (ID: %d) to MongoDB: %w", condition.Name, condition.ID, err)
+	}
+	return nil
+}
+
+func (r *MongoEncounterRepository) GetPokemonEncountersLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+func (r *MongoEncounterRepository) GetLocationLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.locationCollection, ids)
+}
+
+func (r *MongoEncounterRepository) GetLocationAreaLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.areaCollection, ids)
+}
+
+func (r *MongoEncounterRepository) GetEncounterMethodLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.methodCollection, ids)
+}
+
+func (r *MongoEncounterRepository) GetEncounterConditionLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.conditionCollection, ids)
+}
+
+// GetPokemonRef looks up a pokemon by ID (when id > 0) or by name in the pokemons collection.
+func (r *MongoEncounterRepository) GetPokemonRef(ctx context.Context, id int, name string) (model.PokemonRef, error) {
+	filter := bson.M{"name": name}
+	if id > 0 {
+		filter = bson.M{"id": id}
+	}
+
+	var doc model.PokemonRef
+	findOptions := options.FindOne().SetProjection(bson.M{"id": 1, "name": 1, "species": 1})
+	err := r.pokemonCollection.FindOne(ctx, tombstone.Active(filter), findOptions).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			if id > 0 {
+				return model.PokemonRef{}, fmt.Errorf("pokemon not found: %d", id)
+			}
+			return model.PokemonRef{}, fmt.Errorf("pokemon not found: %s", name)
+		}
+		return model.PokemonRef{}, fmt.Errorf("failed to retrieve pokemon from DB: %w", err)
+	}
+	return doc, nil
+}
+
+// GetPokemonEncounters returns the stored encounters of a pokemon. A pokemon
+// whose encounters have not been synced yet simply has none.
+func (r *MongoEncounterRepository) GetPokemonEncounters(ctx context.Context, pokemonID int) ([]model.LocationAreaEncounter, error) {
+	var doc model.PokemonEncountersDocument
+	err := r.collection.FindOne(ctx, bson.M{"id": pokemonID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve encounters from DB: %w", err)
+	}
+	return doc.Encounters, nil
+}
+
+// GetLocationAreasByName retrieves the location areas whose name is in names, keyed by name.
+func (r *MongoEncounterRepository) GetLocationAreasByName(ctx context.Context, names []string) (map[string]model.LocationArea, error) {
+	var docs []model.LocationAreaDocument
+	if err := findByNames(ctx, r.areaCollection, names, &docs); err != nil {
+		return nil, fmt.Errorf("failed to retrieve location areas from DB: %w", err)
+	}
+
+	areas := make(map[string]model.LocationArea, len(docs))
+	for _, doc := range docs {
+		areas[doc.Name] = model.LocationArea{
+			ID:        doc.LocationAreaID,
+			Name:      doc.Name,
+			GameIndex: doc.GameIndex,
+			Location:  doc.Location,
+			Names:     doc.Names,
+		}
+	}
+	return areas, nil
+}
+
+// GetLocationsByName retrieves the locations whose name is in names, keyed by name.
+func (r *MongoEncounterRepository) GetLocationsByName(ctx context.Context, names []string) (map[string]model.Location, error) {
+	var docs []model.LocationDocument
+	if err := findByNames(ctx, r.locationCollection, names, &docs); err != nil {
+		return nil, fmt.Errorf("failed to retrieve locations from DB: %w", err)
+	}
+
+	locations := make(map[string]model.Location, len(docs))
+	for _, doc := range docs {
+		locations[doc.Name] = model.Location{
+			ID:     doc.LocationID,
+			Name:   doc.Name,
+			Region: doc.Region,
+			Names:  doc.Names,
+			Areas:  doc.Areas,
+		}
+	}
+	return locations, nil
+}
+
+// GetEncounterMethods returns every stored encounter method, keyed by name.
+func (r *MongoEncounterRepository) GetEncounterMethods(ctx context.Context) (map[string]model.EncounterMethod, error) {
+	cursor, err := r.methodCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve encounter methods from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []model.EncounterMethodDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode encounter methods from DB: %w", err)
+	}
+
+	methods := make(map[string]model.EncounterMethod, len(docs))
+	for _, doc := range docs {
+		methods[doc.Name] = model.EncounterMethod{
+			ID:    doc.MethodID,
+			Name:  doc.Name,
+			Order: doc.Order,
+			Names: doc.Names,
+		}
+	}
+	return methods, nil
+}
+
+// GetConditionByValue maps every encounter condition value (e.g. "time-morning")
+// to the name of the condition it belongs to (e.g. "time").
+func (r *MongoEncounterRepository) GetConditionByValue(ctx context.Context) (map[string]string, error) {
+	cursor, err := r.conditionCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve encounter conditions from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []model.EncounterConditionDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode encounter conditions from DB: %w", err)
+	}
+
+	conditionByValue := make(map[string]string)
+	for _, doc := range docs {
+		for _, value := range doc.Values {
+			conditionByValue[value.Name] = doc.Name
+		}
+	}
+	return conditionByValue, nil
+}
+
+// GetPalParkEncounters returns the Pal Park encounters stored on a pokemon species.
+func (r *MongoEncounterRepository) GetPalParkEncounters(ctx context.Context, speciesName string) ([]model.PalParkEncounterInfo, error) {
+	var doc struct {
+		PalParkEncounters []struct {
+			Area      model.ResourceReference `bson:"area"`
+			BaseScore int                     `bson:"base_score"`
+			Rate      int                     `bson:"rate"`
+		} `bson:"pal_park_encounters"`
+	}
+
+	findOptions := options.FindOne().SetProjection(bson.M{"pal_park_encounters": 1})
+	err := r.collectionSpecies.FindOne(ctx, bson.M{"name": speciesName}, findOptions).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve pal park encounters from DB: %w", err)
+	}
+
+	encounters := make([]model.PalParkEncounterInfo, 0, len(doc.PalParkEncounters))
+	for _, e := range doc.PalParkEncounters {
+		encounters = append(encounters, model.PalParkEncounterInfo{
+			Area:      e.Area.Name,
+			BaseScore: e.BaseScore,
+			Rate:      e.Rate,
+		})
+	}
+	return encounters, nil
+}
+
+// upsertByID stores doc in coll, replacing the fields of the document with the same PokeAPI 'id'.
+func upsertByID(ctx context.Context, coll *mongo.Collection, id int, doc interface{}) error {
+	filter := bson.M{"id": id}
+	update := bson.M{"$set": doc}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := coll.UpdateOne(ctx, filter, update, opts)
+	return err
+}
+
+// findByNames decodes every document of coll whose name is in names into docs.
+func findByNames(ctx context.Context, coll *mongo.Collection, names []string, docs interface{}) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	cursor, err := coll.Find(ctx, bson.M{"name": bson.M{"$in": names}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	return cursor.All(ctx, docs)
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"pokedex/internal/encounter/model"
+	"pokedex/internal/encounter/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EncounterService defines the business logic for wild encounters.
+type EncounterService interface {
+	SyncAllPokemonEncounters(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncAllLocations(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncAllLocationAreas(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncAllEncounterMethods(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncAllEncounterConditions(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+
+	SyncPokemonEncounters(ctx context.Context, url string) error
+	SyncLocation(ctx context.Context, url string) error
+	SyncLocationArea(ctx context.Context, url string) error
+	SyncEncounterMethod(ctx context.Context, url string) error
+	SyncEncounterCondition(ctx context.Context, url string) error
+
+	GetPokemonEncounters(ctx context.Context, identifier string) (model.PokemonEncountersResponse, error)
+}
+
+// encounterServiceImpl implements the EncounterService interface.
+type encounterServiceImpl struct {
+	encounterRepo repository.EncounterRepository
+	pokeAPIClient *pokeapi.Client
+}
+
+// NewEncounterService creates a new instance of EncounterService.
+func NewEncounterService(repo repository.EncounterRepository, api *pokeapi.Client) EncounterService {
+	return &encounterServiceImpl{
+		encounterRepo: repo,
+		pokeAPIClient: api,
+	}
+}
+
+// SyncAllPokemonEncounters fetches the encounters sub-resource of every pokemon and saves it.
+func (s *encounterServiceImpl) SyncAllPokemonEncounters(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.pokemonEncounterResource())
+}
+
+// SyncAllLocations fetches all locations from PokeAPI and saves them to the repository.
+func (s *encounterServiceImpl) SyncAllLocations(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.locationResource())
+}
+
+// SyncAllLocationAreas fetches all location areas from PokeAPI and saves them to the repository.
+func (s *encounterServiceImpl) SyncAllLocationAreas(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.locationAreaResource())
+}
+
+// SyncAllEncounterMethods fetches all encounter methods from PokeAPI and saves them to the repository.
+func (s *encounterServiceImpl) SyncAllEncounterMethods(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.encounterMethodResource())
+}
+
+// SyncAllEncounterConditions fetches all encounter conditions from PokeAPI and saves them to the repository.
+func (s *encounterServiceImpl) SyncAllEncounterConditions(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.encounterConditionResource())
+}
+
+// SyncPokemonEncounters fetches the encounters of a single pokemon by its PokeAPI URL and saves them.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *encounterServiceImpl) SyncPokemonEncounters(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.pokemonEncounterResource(), url)
+}
+
+// SyncLocation fetches a single location by its PokeAPI URL and saves it.
+func (s *encounterServiceImpl) SyncLocation(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.locationResource(), url)
+}
+
+// SyncLocationArea fetches a single location area by its PokeAPI URL and saves it.
+func (s *encounterServiceImpl) SyncLocationArea(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.locationAreaResource(), url)
+}
+
+// SyncEncounterMethod fetches a single encounter method by its PokeAPI URL and saves it.
+func (s *encounterServiceImpl) SyncEncounterMethod(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.encounterMethodResource(), url)
+}
+
+// SyncEncounterCondition fetches a single encounter condition by its PokeAPI URL and saves it.
+func (s *encounterServiceImpl) SyncEncounterCondition(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.encounterConditionResource(), url)
+}
+
+// pokemonEncounterResource pages through the pokemon list and fetches the
+// /pokemon/{id}/encounters sub-resource of every entry.
+func (s *encounterServiceImpl) pokemonEncounterResource() syncer.Resource[model.PokemonEncounters] {
+	return syncer.Resource[model.PokemonEncounters]{
+		Name:         "pokemon-encounter",
+		Endpoint:     "pokemon",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchPokemonEncounters,
+		Save:         s.encounterRepo.SavePokemonEncounters,
+		LastSyncedAt: s.encounterRepo.GetPokemonEncountersLastSyncedAt,
+	}
+}
+
+func (s *encounterServiceImpl) locationResource() syncer.Resource[model.Location] {
+	return syncer.Resource[model.Location]{
+		Name:         "location",
+		Endpoint:     "location",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchLocationDetail,
+		Save:         s.encounterRepo.SaveLocation,
+		LastSyncedAt: s.encounterRepo.GetLocationLastSyncedAt,
+	}
+}
+
+func (s *encounterServiceImpl) locationAreaResource() syncer.Resource[model.LocationArea] {
+	return syncer.Resource[model.LocationArea]{
+		Name:         "location-area",
+		Endpoint:     "location-area",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchLocationAreaDetail,
+		Save:         s.encounterRepo.SaveLocationArea,
+		LastSyncedAt: s.encounterRepo.GetLocationAreaLastSyncedAt,
+	}
+}
+
+func (s *encounterServiceImpl) encounterMethodResource() syncer.Resource[model.EncounterMethod] {
+	return syncer.Resource[model.EncounterMethod]{
+		Name:         "encounter-method",
+		Endpoint:     "encounter-method",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchEncounterMethodDetail,
+		Save:         s.encounterRepo.SaveEncounterMethod,
+		LastSyncedAt: s.encounterRepo.GetEncounterMethodLastSyncedAt,
+	}
+}
+
+func (s *encounterServiceImpl) encounterConditionResource() syncer.Resource[model.EncounterCondition] {
+	return syncer.Resource[model.EncounterCondition]{
+		Name:         "encounter-condition",
+		Endpoint:     "encounter-condition",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchEncounterConditionDetail,
+		Save:         s.encounterRepo.SaveEncounterCondition,
+		LastSyncedAt: s.encounterRepo.GetEncounterConditionLastSyncedAt,
+	}
+}
+
+// GetPokemonEncounters returns where a pokemon can be caught, grouped by version,
+// together with the Pal Park encounters of its species.
+func (s *encounterServiceImpl) GetPokemonEncounters(ctx context.Context, identifier string) (model.PokemonEncountersResponse, error) {
+	id, _ := strconv.Atoi(identifier)
+	pokemon, err := s.encounterRepo.GetPokemonRef(ctx, id, strings.ToLower(identifier))
+	if err != nil {
+		return model.PokemonEncountersResponse{}, err
+	}
+
+	encounters, err := s.encounterRepo.GetPokemonEncounters(ctx, pokemon.ID)
+	if err != nil {
+		return model.PokemonEncountersResponse{}, err
+	}
+
+	palPark, err := s.encounterRepo.GetPalParkEncounters(ctx, pokemon.Species.Name)
+	if err != nil {
+		return model.PokemonEncountersResponse{}, err
+	}
+	if palPark == nil {
+		palPark = []model.PalParkEncounterInfo{}
+	}
+
+	// Data referensi (area, lokasi, method, kondisi) boleh belum di-sync; field nama dibiarkan kosong
+	areaNames := make([]string, 0, len(encounters))
+	for _, e := range encounters {
+		areaNames = append(areaNames, e.LocationArea.Name)
+	}
+	areas, err := s.encounterRepo.GetLocationAreasByName(ctx, areaNames)
+	if err != nil {
+		return model.PokemonEncountersResponse{}, err
+	}
+
+	locationNames := make([]string, 0, len(areas))
+	for _, area := range areas {
+		locationNames = append(locationNames, area.Location.Name)
+	}
+	locations, err := s.encounterRepo.GetLocationsByName(ctx, locationNames)
+	if err != nil {
+		return model.PokemonEncountersResponse{}, err
+	}
+
+	methods, err := s.encounterRepo.GetEncounterMethods(ctx)
+	if err != nil {
+		return model.PokemonEncountersResponse{}, err
+	}
+	conditionByValue, err := s.encounterRepo.GetConditionByValue(ctx)
+	if err != nil {
+		return model.PokemonEncountersResponse{}, err
+	}
+
+	return model.PokemonEncountersResponse{
+		PokemonID:         pokemon.ID,
+		PokemonName:       pokemon.Name,
+		Versions:          groupEncountersByVersion(encounters, areas, locations, methods, conditionByValue),
+		PalParkEncounters: palPark,
+	}, nil
+}
+
+// groupEncountersByVersion turns PokeAPI's area -> version layout into version -> area,
+// merging the encounter slots of one area that share method and conditions.
+func groupEncountersByVersion(
+	encounters []model.LocationAreaEncounter,
+	areas map[string]model.LocationArea,
+	locations map[string]model.Location,
+	methods map[string]model.EncounterMethod,
+	conditionByValue map[string]string,
+) []model.VersionEncounters {
+	byVersion := make(map[string]*model.VersionEncounters)
+	versionIDs := make(map[string]int)
+	var versionOrder []string
+
+	for _, e := range encounters {
+		area := areas[e.LocationArea.Name]
+		location := locations[area.Location.Name]
+
+		for _, vd := range e.VersionDetails {
+			group, ok := byVersion[vd.Version.Name]
+			if !ok {
+				group = &model.VersionEncounters{Version: vd.Version.Name}
+				byVersion[vd.Version.Name] = group
+				versionIDs[vd.Version.Name] = utils.ExtractIDFromURL(vd.Version.URL)
+				versionOrder = append(versionOrder, vd.Version.Name)
+			}
+
+			locationEncounter := model.LocationEncounter{
+				LocationArea:     e.LocationArea.Name,
+				LocationAreaName: utils.EnglishText(area.Names),
+				Location:         area.Location.Name,
+				LocationName:     utils.EnglishText(location.Names),
+				MaxChance:        vd.MaxChance,
+				Encounters:       mergeEncounterSlots(vd.EncounterDetails, methods, conditionByValue),
+			}
+			if location.Region != nil {
+				locationEncounter.Region = location.Region.Name
+			}
+			group.Locations = append(group.Locations, locationEncounter)
+		}
+	}
+
+	// Urutkan versi berdasarkan ID versi di PokeAPI (red, blue, ... terbaru)
+	sort.SliceStable(versionOrder, func(i, j int) bool {
+		return versionIDs[versionOrder[i]] < versionIDs[versionOrder[j]]
+	})
+
+	result := make([]model.VersionEncounters, 0, len(versionOrder))
+	for _, name := range versionOrder {
+		result = append(result, *byVersion[name])
+	}
+	return result
+}
+
+// mergeEncounterSlots collapses the encounter slots that share a method and
+// set of conditions into one entry with the full level range and summed chance.
+func mergeEncounterSlots(slots []model.Encounter, methods map[string]model.EncounterMethod, conditionByValue map[string]string) []model.EncounterInfo {
+	merged := make(map[string]*model.EncounterInfo)
+	var keys []string
+
+	for _, slot := range slots {
+		values := make([]string, 0, len(slot.ConditionValues))
+		for _, v := range slot.ConditionValues {
+			values = append(values, v.Name)
+		}
+		sort.Strings(values)
+		key := slot.Method.Name + "|" + strings.Join(values, ",")
+
+		info, ok := merged[key]
+		if !ok {
+			conditions := make([]model.EncounterConditionInfo, 0, len(values))
+			for _, v := range values {
+				conditions = append(conditions, model.EncounterConditionInfo{Condition: conditionByValue[v], Value: v})
+			}
+			info = &model.EncounterInfo{
+				Method:     slot.Method.Name,
+				MethodName: utils.EnglishText(methods[slot.Method.Name].Names),
+				MinLevel:   slot.MinLevel,
+				MaxLevel:   slot.MaxLevel,
+				Conditions: conditions,
+			}
+			merged[key] = info
+			keys = append(keys, key)
+		}
+
+		info.MinLevel = min(info.MinLevel, slot.MinLevel)
+		info.MaxLevel = max(info.MaxLevel, slot.MaxLevel)
+		info.Chance += slot.Chance
+	}
+
+	// Urutkan berdasarkan urutan method dari PokeAPI, lalu kondisi
+	sort.SliceStable(keys, func(i, j int) bool {
+		oi, oj := methods[merged[keys[i]].Method].Order, methods[merged[keys[j]].Method].Order
+		if oi != oj {
+			return oi < oj
+		}
+		return keys[i] < keys[j]
+	})
+
+	result := make([]model.EncounterInfo, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, *merged[key])
+	}
+	return result
+}
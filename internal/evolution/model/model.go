@@ -1,6 +1,10 @@
 package model
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	item_model "pokedex/internal/item/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 type ResourceReference struct {
 	Name string `json:"name" bson:"name"`
@@ -11,6 +15,9 @@ type EvolutionChain struct {
 	ID              int               `json:"id" bson:"id"`
 	BabyTriggerItem ResourceReference `json:"baby_trigger_item" bson:"baby_trigger_item"`
 	Chain           ChainLink         `json:"chain" bson:"chain"`
+
+	// Diisi saat request dari koleksi items, tidak disimpan bersama chain
+	BabyTriggerItemDetail *item_model.ItemDetailResponse `json:"baby_trigger_item_detail,omitempty" bson:"-"`
 }
 
 type ChainLink struct {
@@ -40,6 +47,10 @@ type EvolutionDetail struct {
 	TimeOfDay             string            `json:"time_of_day" bson:"time_of_day"`
 	TradeSpecies          ResourceReference `json:"trade_species" bson:"trade_species"`
 	TurnUpsideDown        bool              `json:"turn_upside_down" bson:"turn_upside_down"`
+
+	// Full item records for Item and HeldItem, resolved at request time
+	ItemDetail     *item_model.ItemDetailResponse `json:"item_detail,omitempty" bson:"-"`
+	HeldItemDetail *item_model.ItemDetailResponse `json:"held_item_detail,omitempty" bson:"-"`
 }
 
 type EvolutionListItem struct {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"pokedex/database"
 	"pokedex/internal/evolution/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -21,6 +23,7 @@ type EvolutionRepository interface {
 	GetEvolutionByName(ctx context.Context, name string) (model.EvolutionChain, error)
 	GetEvolutionPokemonType(ctx context.Context, id int) (model.EvolutionPokemonResponse, error)
 	GetPokemonInfo(ctx context.Context, pokemon_name string) (model.EvolutionPokemonInfo, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
 }
 
 type MongoEvolutionRepository struct {
@@ -84,7 +87,7 @@ func (r *MongoEvolutionRepository) GetEvolutionByName(ctx context.Context, name
 
 func (r *MongoEvolutionRepository) GetEvolutionPokemonType(ctx context.Context, pokemon_id int) (model.EvolutionPokemonResponse, error) {
 	var doc model.EvolutionPokemonTypeDocument
-	filter := bson.M{"id": pokemon_id}
+	filter := tombstone.Active(bson.M{"id": pokemon_id})
 	findOptions := options.FindOne()
 
 	projection := bson.D{
@@ -109,7 +112,7 @@ func (r *MongoEvolutionRepository) GetEvolutionPokemonType(ctx context.Context,
 
 func (r *MongoEvolutionRepository) GetPokemonInfo(ctx context.Context, pokemon_name string) (model.EvolutionPokemonInfo, error) {
 	var doc model.EvolutionPokemonInfoDocument
-	filter := bson.M{"name": pokemon_name}
+	filter := tombstone.Active(bson.M{"name": pokemon_name})
 	findOptions := options.FindOne()
 
 	projection := bson.D{
@@ -131,6 +134,11 @@ func (r *MongoEvolutionRepository) GetPokemonInfo(ctx context.Context, pokemon_n
 	return r.toDetailPokemonInfo(doc), nil
 }
 
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored evolution chain whose ID is in ids.
+func (r *MongoEvolutionRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
 func (r *MongoEvolutionRepository) toDetail(doc model.EvolutionChainDocument) model.EvolutionChain {
 	evoDetail := model.EvolutionChain{
 		ID:              doc.EvolutionID,
@@ -3,18 +3,20 @@ package service
 import (
 	"context"
 	"fmt"
-	"log"
 	"pokedex/internal/evolution/model"
 	"pokedex/internal/evolution/repository"
+	item_model "pokedex/internal/item/model"
+	item_repository "pokedex/internal/item/repository"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/spritemirror"
+	"pokedex/internal/shared/syncer"
 	"pokedex/utils"
 	"strconv"
-	"sync"
-	"time"
 )
 
 type EvolutionService interface {
-	SyncAllEvolution(ctx context.Context) error
+	SyncAllEvolution(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncEvolution(ctx context.Context, url string) error
 	GetEvolution(ctx context.Context, identifier string) (model.EvolutionChain, error)
 	GetEvolutionPokemonType(ctx context.Context, id int) (model.EvolutionPokemonResponse, error)
 }
@@ -22,93 +24,37 @@ type EvolutionService interface {
 type evolutionServiceImpl struct {
 	evolutionRepo repository.EvolutionRepository
 	pokeAPIClient *pokeapi.Client
+	itemRepo      item_repository.ItemRepository
 }
 
-func NewEvolutionService(repo repository.EvolutionRepository, api *pokeapi.Client) EvolutionService {
+func NewEvolutionService(repo repository.EvolutionRepository, api *pokeapi.Client, itemRepo item_repository.ItemRepository) EvolutionService {
 	return &evolutionServiceImpl{
 		evolutionRepo: repo,
 		pokeAPIClient: api,
+		itemRepo:      itemRepo,
 	}
 }
 
-func (s *evolutionServiceImpl) SyncAllEvolution(ctx context.Context) error {
-	log.Println("Starting full data synchronization...")
-
-	limit := 100 // Fetch 100 pokemons at a time from PokeAPI
-	offset := 0
-	totalSynced := 0
-
-	for {
-		listCtx, cancelList := context.WithTimeout(ctx, 30*time.Second)
-		listResponse, err := s.pokeAPIClient.FetchEvolutionList(listCtx, limit, offset)
-		cancelList()
-
-		if err != nil {
-			if err.Error() == "rate_limit_hit" { // Check for the string error, can be improved with custom error types
-				log.Println("Rate limit hit during list fetch, retrying after a delay...")
-				time.Sleep(5 * time.Second)
-				continue
-			}
-			return fmt.Errorf("failed to fetch data list from PokeAPI: %w", err)
-		}
-
-		if len(listResponse.Results) == 0 {
-			break // No more data to fetch
-		}
-
-		var wg sync.WaitGroup
-		resultsChan := make(chan struct {
-			Detail model.EvolutionChain
-			Err    error
-		}, len(listResponse.Results))
-
-		// Enqueue each detail fetch through the shared client
-		for _, item := range listResponse.Results {
-			wg.Add(1)
-			go func(item model.EvolutionListItem) {
-				defer wg.Done()
-				detail, err := s.pokeAPIClient.FetchEvolutionDetail(ctx, item.URL)
-				resultsChan <- struct {
-					Detail model.EvolutionChain
-					Err    error
-				}{Detail: detail, Err: err}
-			}(item)
-		}
-
-		// Wait for all detail fetches for the current batch to complete
-		go func() {
-			wg.Wait()
-			close(resultsChan)
-		}()
-
-		for res := range resultsChan {
-			if res.Err != nil {
-				log.Printf("Error fetching detail for a data: %v\n", res.Err)
-				if res.Err.Error() == "rate_limit_hit" {
-					log.Println("Rate limit hit during detail fetch, consider re-queuing or pausing sync.")
-				}
-				continue
-			}
-
-			// Save to Repository
-			err := s.evolutionRepo.SaveEvolution(ctx, res.Detail)
-			if err != nil {
-				log.Printf("Failed to save data (ID: %d) to repository: %v\n", res.Detail.ID, err)
-			} else {
-				totalSynced++
-			}
-		}
+func (s *evolutionServiceImpl) SyncAllEvolution(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
 
-		log.Printf("Batch processed. Total synced so far: %d\n", totalSynced)
+// SyncEvolution fetches a single evolution chain by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *evolutionServiceImpl) SyncEvolution(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
 
-		offset += limit
-		if offset >= listResponse.Count {
-			break
-		}
+// syncResource describes how evolution chains are listed, fetched and stored by the sync engine.
+func (s *evolutionServiceImpl) syncResource() syncer.Resource[model.EvolutionChain] {
+	return syncer.Resource[model.EvolutionChain]{
+		Name:         "evolution",
+		Endpoint:     "evolution-chain",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchEvolutionDetail,
+		Save:         s.evolutionRepo.SaveEvolution,
+		LastSyncedAt: s.evolutionRepo.GetLastSyncedAt,
 	}
-
-	log.Printf("Full Pokémon data synchronization completed. Total unique data synced: %d\n", totalSynced)
-	return nil
 }
 
 func (s *evolutionServiceImpl) GetEvolution(ctx context.Context, identifier string) (model.EvolutionChain, error) {
@@ -126,6 +72,11 @@ func (s *evolutionServiceImpl) GetEvolution(ctx context.Context, identifier stri
 		return model.EvolutionChain{}, fmt.Errorf("failed to populate evolution chain details: %w", err)
 	}
 
+	// Item yang belum di-sync cukup dilewati, chain tetap dikembalikan
+	if err := s.populateEvolutionItems(ctx, &evoDetail); err != nil {
+		fmt.Printf("Warning: Failed to populate evolution items for chain %d: %v\n", evoDetail.ID, err)
+	}
+
 	return evoDetail, err
 }
 
@@ -146,7 +97,7 @@ func (s *evolutionServiceImpl) populateEvolutionChainDetails(ctx context.Context
 	pokemonName := chainLink.Species.Name
 	pokemonInfoResponse, _ := s.evolutionRepo.GetPokemonInfo(ctx, pokemonName)
 	pokemonID := pokemonInfoResponse.ID
-	thumbnailImg := utils.GetThumbnailPokemon(pokemonID)
+	thumbnailImg := spritemirror.URL(utils.GetThumbnailPokemon(pokemonID))
 
 	chainLink.PokemonInfo = model.EvolutionPokemonInfoResponse{
 		ID:        pokemonID,
@@ -178,3 +129,57 @@ func (s *evolutionServiceImpl) populateEvolutionChainDetails(ctx context.Context
 
 	return nil // No error encountered in this branch
 }
+
+// populateEvolutionItems links every item referenced by the chain (evolution
+// item, held item and baby trigger item) to its full item record.
+func (s *evolutionServiceImpl) populateEvolutionItems(ctx context.Context, evolution *model.EvolutionChain) error {
+	var names []string
+	if evolution.BabyTriggerItem.Name != "" {
+		names = append(names, evolution.BabyTriggerItem.Name)
+	}
+	names = collectItemNames(&evolution.Chain, names)
+	if len(names) == 0 {
+		return nil
+	}
+
+	items, err := s.itemRepo.GetItemsByName(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	if item, ok := items[evolution.BabyTriggerItem.Name]; ok {
+		evolution.BabyTriggerItemDetail = &item
+	}
+	attachItems(&evolution.Chain, items)
+	return nil
+}
+
+func collectItemNames(chainLink *model.ChainLink, names []string) []string {
+	for _, detail := range chainLink.EvolutionDetails {
+		if detail.Item.Name != "" {
+			names = append(names, detail.Item.Name)
+		}
+		if detail.HeldItem.Name != "" {
+			names = append(names, detail.HeldItem.Name)
+		}
+	}
+	for i := range chainLink.EvolvesTo {
+		names = collectItemNames(&chainLink.EvolvesTo[i], names)
+	}
+	return names
+}
+
+func attachItems(chainLink *model.ChainLink, items map[string]item_model.ItemDetailResponse) {
+	for i := range chainLink.EvolutionDetails {
+		detail := &chainLink.EvolutionDetails[i]
+		if item, ok := items[detail.Item.Name]; ok {
+			detail.ItemDetail = &item
+		}
+		if item, ok := items[detail.HeldItem.Name]; ok {
+			detail.HeldItemDetail = &item
+		}
+	}
+	for i := range chainLink.EvolvesTo {
+		attachItems(&chainLink.EvolvesTo[i], items)
+	}
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/generation/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GenerationHandler struct {
+	generationService service.GenerationService
+}
+
+func NewGenerationHandler(svc service.GenerationService) *GenerationHandler {
+	return &GenerationHandler{
+		generationService: svc,
+	}
+}
+
+func (h *GenerationHandler) GetGenerationList(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	listResponse, err := h.generationService.GetGenerationList(ctx, limit, offset, baseURL(c, "generation"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve generation list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *GenerationHandler) GetGenerationDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	generation, err := h.generationService.GetGeneration(ctx, identifier)
+	if err != nil {
+		if err.Error() == fmt.Sprintf("generation not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve generation detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, generation)
+}
+
+func (h *GenerationHandler) GetVersionGroupList(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	listResponse, err := h.generationService.GetVersionGroupList(ctx, limit, offset, baseURL(c, "version-group"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve version group list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *GenerationHandler) GetVersionGroupDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	versionGroup, err := h.generationService.GetVersionGroup(ctx, identifier)
+	if err != nil {
+		if err.Error() == fmt.Sprintf("version group not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve version group detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, versionGroup)
+}
+
+// pagination reads ?limit= and ?offset=, falling back to 20 and 0.
+func pagination(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// baseURL builds the absolute URL of a v1 resource from the request's scheme and host.
+func baseURL(c *gin.Context, resource string) string {
+	// Mendapatkan skema (http/https), host, dan path dasar dari request
+	scheme := "http"
+	if c.Request.TLS != nil { // Cek apakah koneksi menggunakan HTTPS
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/api/v1/%s", scheme, c.Request.Host, resource)
+}
@@ -0,0 +1,155 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+func (n NameEntry) LanguageName() string  { return n.Language.Name }
+func (n NameEntry) LocalizedText() string { return n.Name }
+
+// --- PokeAPI /generation, /version-group, /version ---
+
+type GenerationDetail struct {
+	ID             int                 `json:"id" bson:"id"`
+	Name           string              `json:"name" bson:"name"`
+	MainRegion     ResourceReference   `json:"main_region" bson:"main_region"`
+	Names          []NameEntry         `json:"names" bson:"names"`
+	Abilities      []ResourceReference `json:"abilities" bson:"abilities"`
+	Moves          []ResourceReference `json:"moves" bson:"moves"`
+	PokemonSpecies []ResourceReference `json:"pokemon_species" bson:"pokemon_species"`
+	Types          []ResourceReference `json:"types" bson:"types"`
+	VersionGroups  []ResourceReference `json:"version_groups" bson:"version_groups"`
+}
+
+type VersionGroupDetail struct {
+	ID               int                 `json:"id" bson:"id"`
+	Name             string              `json:"name" bson:"name"`
+	Order            int                 `json:"order" bson:"order"`
+	Generation       ResourceReference   `json:"generation" bson:"generation"`
+	MoveLearnMethods []ResourceReference `json:"move_learn_methods" bson:"move_learn_methods"`
+	Pokedexes        []ResourceReference `json:"pokedexes" bson:"pokedexes"`
+	Regions          []ResourceReference `json:"regions" bson:"regions"`
+	Versions         []ResourceReference `json:"versions" bson:"versions"`
+}
+
+type VersionDetail struct {
+	ID           int               `json:"id" bson:"id"`
+	Name         string            `json:"name" bson:"name"`
+	Names        []NameEntry       `json:"names" bson:"names"`
+	VersionGroup ResourceReference `json:"version_group" bson:"version_group"`
+}
+
+// --- Response ---
+
+// Introduced lists what first appeared in a generation, each sorted by PokeAPI ID.
+type Introduced struct {
+	PokemonSpecies []string `json:"pokemon_species"`
+	Moves          []string `json:"moves"`
+	Abilities      []string `json:"abilities"`
+	Types          []string `json:"types"`
+}
+
+type GenerationResponse struct {
+	ID            int        `json:"id"`
+	Name          string     `json:"name"`
+	DisplayName   string     `json:"display_name"`
+	MainRegion    string     `json:"main_region"`
+	VersionGroups []string   `json:"version_groups"` // Diurutkan berdasarkan order version group
+	Introduced    Introduced `json:"introduced"`
+}
+
+type VersionInfo struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"` // Kosong jika version belum di-sync
+}
+
+// VersionGroupResponse exposes a version group with the introductions of its generation.
+type VersionGroupResponse struct {
+	ID               int           `json:"id"`
+	Name             string        `json:"name"`
+	Order            int           `json:"order"`
+	Generation       string        `json:"generation"`
+	Regions          []string      `json:"regions"`
+	Versions         []VersionInfo `json:"versions"`
+	MoveLearnMethods []string      `json:"move_learn_methods"`
+	Pokedexes        []string      `json:"pokedexes"`
+	Introduced       *Introduced   `json:"introduced"` // null jika generation belum di-sync
+}
+
+type GenerationListItem struct {
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	URL           string   `json:"url"`
+	MainRegion    string   `json:"main_region"`
+	VersionGroups []string `json:"version_groups"`
+}
+
+type GenerationListResponse struct {
+	Count    int                  `json:"count"`
+	Next     *string              `json:"next"`
+	Previous *string              `json:"previous"`
+	Results  []GenerationListItem `json:"results"`
+}
+
+type VersionGroupListItem struct {
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	URL        string   `json:"url"`
+	Order      int      `json:"order"`
+	Generation string   `json:"generation"`
+	Versions   []string `json:"versions"`
+}
+
+type VersionGroupListResponse struct {
+	Count    int                    `json:"count"`
+	Next     *string                `json:"next"`
+	Previous *string                `json:"previous"`
+	Results  []VersionGroupListItem `json:"results"`
+}
+
+// --- MongoDB documents ---
+
+type GenerationDocument struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty"`
+	GenerationID   int                 `bson:"id"`
+	Name           string              `bson:"name"`
+	MainRegion     ResourceReference   `bson:"main_region"`
+	Names          []NameEntry         `bson:"names"`
+	Abilities      []ResourceReference `bson:"abilities"`
+	Moves          []ResourceReference `bson:"moves"`
+	PokemonSpecies []ResourceReference `bson:"pokemon_species"`
+	Types          []ResourceReference `bson:"types"`
+	VersionGroups  []ResourceReference `bson:"version_groups"`
+	LastSyncedAt   int64               `bson:"last_synced_at"`
+}
+
+type VersionGroupDocument struct {
+	ID               primitive.ObjectID  `bson:"_id,omitempty"`
+	VersionGroupID   int                 `bson:"id"`
+	Name             string              `bson:"name"`
+	Order            int                 `bson:"order"`
+	Generation       ResourceReference   `bson:"generation"`
+	MoveLearnMethods []ResourceReference `bson:"move_learn_methods"`
+	Pokedexes        []ResourceReference `bson:"pokedexes"`
+	Regions          []ResourceReference `bson:"regions"`
+	Versions         []ResourceReference `bson:"versions"`
+	LastSyncedAt     int64               `bson:"last_synced_at"`
+}
+
+type VersionDocument struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	VersionID    int                `bson:"id"`
+	Name         string             `bson:"name"`
+	Names        []NameEntry        `bson:"names"`
+	VersionGroup ResourceReference  `bson:"version_group"`
+	LastSyncedAt int64              `bson:"last_synced_at"`
+}
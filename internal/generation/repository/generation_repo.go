@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/generation/model"
+	"pokedex/internal/shared/syncer"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	generationCollectionName = "generations"
+
+	// VersionGroupCollectionName is shared with the pokemon repository, which
+	// validates and orders version group names against it.
+	VersionGroupCollectionName = "version_groups"
+
+	// VersionCollectionName is shared with the pokemon species repository,
+	// which validates the version filter of dex entries against it.
+	VersionCollectionName = "versions"
+)
+
+// GenerationRepository defines the interface for persisting and retrieving
+// generations, version groups and versions.
+type GenerationRepository interface {
+	SaveGeneration(ctx context.Context, generation model.GenerationDetail) error
+	SaveVersionGroup(ctx context.Context, versionGroup model.VersionGroupDetail) error
+	SaveVersion(ctx context.Context, version model.VersionDetail) error
+
+	GetGenerationLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetVersionGroupLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetVersionLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+
+	GetGenerationByID(ctx context.Context, id int) (model.GenerationDetail, error)
+	GetGenerationByName(ctx context.Context, name string) (model.GenerationDetail, error)
+	GetGenerationList(ctx context.Context, limit, offset int) ([]model.GenerationDetail, int64, error)
+	GetVersionGroupByID(ctx context.Context, id int) (model.VersionGroupDetail, error)
+	GetVersionGroupByName(ctx context.Context, name string) (model.VersionGroupDetail, error)
+	GetVersionGroupList(ctx context.Context, limit, offset int) ([]model.VersionGroupDetail, int64, error)
+	GetVersionGroupOrder(ctx context.Context) (map[string]int, error)
+	GetVersionsByName(ctx context.Context, names []string) (map[string]model.VersionDetail, error)
+}
+
+// MongoGenerationRepository implements the GenerationRepository interface for MongoDB.
+type MongoGenerationRepository struct {
+	collection             *mongo.Collection
+	versionGroupCollection *mongo.Collection
+	versionCollection      *mongo.Collection
+}
+
+// NewMongoGenerationRepository creates a new MongoDB repository for generations and versions.
+func NewMongoGenerationRepository() *MongoGenerationRepository {
+	return &MongoGenerationRepository{
+		collection:             database.MongoDatabase.Collection(generationCollectionName),
+		versionGroupCollection: database.MongoDatabase.Collection(VersionGroupCollectionName),
+		versionCollection:      database.MongoDatabase.Collection(VersionCollectionName),
+	}
+}
+
+// SaveGeneration saves a generation to MongoDB, upserting on 'id'.
+func (r *MongoGenerationRepository) SaveGeneration(ctx context.Context, generation model.GenerationDetail) error {
+	doc := model.GenerationDocument{
+		GenerationID:   generation.ID,
+		Name:           generation.Name,
+		MainRegion:     generation.MainRegion,
+		Names:          generation.Names,
+		Abilities:      generation.Abilities,
+		Moves:          generation.Moves,
+		PokemonSpecies: generation.PokemonSpecies,
+		Types:          generation.Types,
+		VersionGroups:  generation.VersionGroups,
+		LastSyncedAt:   time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.collection, doc.GenerationID, doc); err != nil {
+		return fmt.Errorf("failed to save generation %s (ID: %d) to MongoDB: %w", generation.Name, generation.ID, err)
+	}
+	return nil
+}
+
+// SaveVersionGroup saves a version group to MongoDB, upserting on 'id'.
+func (r *MongoGenerationRepository) SaveVersionGroup(ctx context.Context, versionGroup model.VersionGroupDetail) error {
+	doc := model.VersionGroupDocument{
+		VersionGroupID:   versionGroup.ID,
+		Name:             versionGroup.Name,
+		Order:            versionGroup.Order,
+		Generation:       versionGroup.Generation,
+		MoveLearnMethods: versionGroup.MoveLearnMethods,
+		Pokedexes:        versionGroup.Pokedexes,
+		Regions:          versionGroup.Regions,
+		Versions:         versionGroup.Versions,
+		LastSyncedAt:     time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.versionGroupCollection, doc.VersionGroupID, doc); err != nil {
+		return fmt.Errorf("failed to save version group %s (ID: %d) to MongoDB: %w", versionGroup.Name, versionGroup.ID, err)
+	}
+	return nil
+}
+
+// SaveVersion saves a version to MongoDB, upserting on 'id'.
+func (r *MongoGenerationRepository) SaveVersion(ctx context.Context, version model.VersionDetail) error {
+	doc := model.VersionDocument{
+		VersionID:    version.ID,
+		Name:         version.Name,
+		Names:        version.Names,
+		VersionGroup: version.VersionGroup,
+		LastSyncedAt: time.Now().Unix(),
+	}
+	if err := upsertByID(ctx, r.versionCollection, doc.VersionID, doc); err != nil {
+		return fmt.Errorf("failed to save version %s (ID: %d) to MongoDB: %w", version.Name, version.ID, err)
+	}
+	return nil
+}
+
+func (r *MongoGenerationRepository) GetGenerationLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+func (r *MongoGenerationRepository) GetVersionGroupLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.versionGroupCollection, ids)
+}
+
+func (r *MongoGenerationRepository) GetVersionLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.versionCollection, ids)
+}
+
+// GetGenerationByID retrieves a generation by its original PokeAPI ID from MongoDB.
+func (r *MongoGenerationRepository) GetGenerationByID(ctx context.Context, id int) (model.GenerationDetail, error) {
+	var generation model.GenerationDetail
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&generation)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.GenerationDetail{}, fmt.Errorf("generation not found: %d", id)
+		}
+		return model.GenerationDetail{}, fmt.Errorf("failed to retrieve generation by ID from DB: %w", err)
+	}
+	return generation, nil
+}
+
+// GetGenerationByName retrieves a generation by its name from MongoDB.
+func (r *MongoGenerationRepository) GetGenerationByName(ctx context.Context, name string) (model.GenerationDetail, error) {
+	var generation model.GenerationDetail
+	err := r.collection.FindOne(ctx, bson.M{"name": name}).Decode(&generation)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.GenerationDetail{}, fmt.Errorf("generation not found: %s", name)
+		}
+		return model.GenerationDetail{}, fmt.Errorf("failed to retrieve generation by name from DB: %w", err)
+	}
+	return generation, nil
+}
+
+// GetGenerationList returns a page of generations sorted by ID.
+func (r *MongoGenerationRepository) GetGenerationList(ctx context.Context, limit, offset int) ([]model.GenerationDetail, int64, error) {
+	var generations []model.GenerationDetail
+	totalCount, err := findPage(ctx, r.collection, limit, offset, bson.D{{Key: "id", Value: 1}}, &generations)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve generation list from DB: %w", err)
+	}
+	return generations, totalCount, nil
+}
+
+// GetVersionGroupByID retrieves a version group by its original PokeAPI ID from MongoDB.
+func (r *MongoGenerationRepository) GetVersionGroupByID(ctx context.Context, id int) (model.VersionGroupDetail, error) {
+	var versionGroup model.VersionGroupDetail
+	err := r.versionGroupCollection.FindOne(ctx, bson.M{"id": id}).Decode(&versionGroup)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.VersionGroupDetail{}, fmt.Errorf("version group not found: %d", id)
+		}
+		return model.VersionGroupDetail{}, fmt.Errorf("failed to retrieve version group by ID from DB: %w", err)
+	}
+	return versionGroup, nil
+}
+
+// GetVersionGroupByName retrieves a version group by its name from MongoDB.
+func (r *MongoGenerationRepository) GetVersionGroupByName(ctx context.Context, name string) (model.VersionGroupDetail, error) {
+	var versionGroup model.VersionGroupDetail
+	err := r.versionGroupCollection.FindOne(ctx, bson.M{"name": name}).Decode(&versionGroup)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.VersionGroupDetail{}, fmt.Errorf("version group not found: %s", name)
+		}
+		return model.VersionGroupDetail{}, fmt.Errorf("failed to retrieve version group by name from DB: %w", err)
+	}
+	return versionGroup, nil
+}
+
+// GetVersionGroupList returns a page of version groups in release order.
+func (r *MongoGenerationRepository) GetVersionGroupList(ctx context.Context, limit, offset int) ([]model.VersionGroupDetail, int64, error) {
+	var versionGroups []model.VersionGroupDetail
+	totalCount, err := findPage(ctx, r.versionGroupCollection, limit, offset, bson.D{{Key: "order", Value: 1}}, &versionGroups)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve version group list from DB: %w", err)
+	}
+	return versionGroups, totalCount, nil
+}
+
+// GetVersionGroupOrder returns the release order of every stored version group, keyed by name.
+func (r *MongoGenerationRepository) GetVersionGroupOrder(ctx context.Context) (map[string]int, error) {
+	return VersionGroupOrder(ctx, r.versionGroupCollection)
+}
+
+// GetVersionsByName retrieves the versions whose name is in names, keyed by name.
+func (r *MongoGenerationRepository) GetVersionsByName(ctx context.Context, names []string) (map[string]model.VersionDetail, error) {
+	versions := make(map[string]model.VersionDetail)
+	if len(names) == 0 {
+		return versions, nil
+	}
+
+	cursor, err := r.versionCollection.Find(ctx, bson.M{"name": bson.M{"$in": names}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve versions from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []model.VersionDetail
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode versions from DB: %w", err)
+	}
+
+	for _, doc := range docs {
+		versions[doc.Name] = doc
+	}
+	return versions, nil
+}
+
+// VersionGroupOrder reads the name -> order map from a version_groups collection.
+// It is exported so other repositories can order version group names without
+// depending on the whole GenerationRepository.
+func VersionGroupOrder(ctx context.Context, coll *mongo.Collection) (map[string]int, error) {
+	findOptions := options.Find().SetProjection(bson.M{"name": 1, "order": 1})
+	cursor, err := coll.Find(ctx, bson.M{}, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve version groups from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		Name  string `bson:"name"`
+		Order int    `bson:"order"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode version groups from DB: %w", err)
+	}
+
+	order := make(map[string]int, len(docs))
+	for _, doc := range docs {
+		order[doc.Name] = doc.Order
+	}
+	return order, nil
+}
+
+// upsertByID stores doc in coll, replacing the fields of the document with the same PokeAPI 'id'.
+func upsertByID(ctx context.Context, coll *mongo.Collection, id int, doc interface{}) error {
+	filter := bson.M{"id": id}
+	update := bson.M{"$set": doc}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := coll.UpdateOne(ctx, filter, update, opts)
+	return err
+}
+
+// findPage decodes one sorted page of coll into docs and returns the total document count.
+func findPage(ctx context.Context, coll *mongo.Collection, limit, offset int, sort bson.D, docs interface{}) (int64, error) {
+	totalCount, err := coll.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return 0, err
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(sort)
+
+	cursor, err := coll.Find(ctx, bson.M{}, findOptions)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	return totalCount, cursor.All(ctx, docs)
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pokedex/internal/generation/model"
+	"pokedex/internal/generation/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GenerationService defines the business logic for generations, version groups and versions.
+type GenerationService interface {
+	SyncAllGenerations(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncAllVersionGroups(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncAllVersions(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncGeneration(ctx context.Context, url string) error
+	SyncVersionGroup(ctx context.Context, url string) error
+	SyncVersion(ctx context.Context, url string) error
+
+	GetGeneration(ctx context.Context, identifier string) (model.GenerationResponse, error)
+	GetGenerationList(ctx context.Context, limit, offset int, baseUrl string) (model.GenerationListResponse, error)
+	GetVersionGroup(ctx context.Context, identifier string) (model.VersionGroupResponse, error)
+	GetVersionGroupList(ctx context.Context, limit, offset int, baseUrl string) (model.VersionGroupListResponse, error)
+}
+
+// generationServiceImpl implements the GenerationService interface.
+type generationServiceImpl struct {
+	generationRepo repository.GenerationRepository
+	pokeAPIClient  *pokeapi.Client
+}
+
+// NewGenerationService creates a new instance of GenerationService.
+func NewGenerationService(repo repository.GenerationRepository, api *pokeapi.Client) GenerationService {
+	return &generationServiceImpl{
+		generationRepo: repo,
+		pokeAPIClient:  api,
+	}
+}
+
+// SyncAllGenerations fetches all generations from PokeAPI and saves them to the repository.
+func (s *generationServiceImpl) SyncAllGenerations(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.generationResource())
+}
+
+// SyncAllVersionGroups fetches all version groups from PokeAPI and saves them to the repository.
+func (s *generationServiceImpl) SyncAllVersionGroups(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.versionGroupResource())
+}
+
+// SyncAllVersions fetches all versions from PokeAPI and saves them to the repository.
+func (s *generationServiceImpl) SyncAllVersions(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.versionResource())
+}
+
+// SyncGeneration fetches a single generation by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *generationServiceImpl) SyncGeneration(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.generationResource(), url)
+}
+
+// SyncVersionGroup fetches a single version group by its PokeAPI URL and saves it.
+func (s *generationServiceImpl) SyncVersionGroup(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.versionGroupResource(), url)
+}
+
+// SyncVersion fetches a single version by its PokeAPI URL and saves it.
+func (s *generationServiceImpl) SyncVersion(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.versionResource(), url)
+}
+
+func (s *generationServiceImpl) generationResource() syncer.Resource[model.GenerationDetail] {
+	return syncer.Resource[model.GenerationDetail]{
+		Name:         "generation",
+		Endpoint:     "generation",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchGenerationDetail,
+		Save:         s.generationRepo.SaveGeneration,
+		LastSyncedAt: s.generationRepo.GetGenerationLastSyncedAt,
+	}
+}
+
+func (s *generationServiceImpl) versionGroupResource() syncer.Resource[model.VersionGroupDetail] {
+	return syncer.Resource[model.VersionGroupDetail]{
+		Name:         "version-group",
+		Endpoint:     "version-group",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchVersionGroupDetail,
+		Save:         s.generationRepo.SaveVersionGroup,
+		LastSyncedAt: s.generationRepo.GetVersionGroupLastSyncedAt,
+	}
+}
+
+func (s *generationServiceImpl) versionResource() syncer.Resource[model.VersionDetail] {
+	return syncer.Resource[model.VersionDetail]{
+		Name:         "version",
+		Endpoint:     "version",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchVersionDetail,
+		Save:         s.generationRepo.SaveVersion,
+		LastSyncedAt: s.generationRepo.GetVersionLastSyncedAt,
+	}
+}
+
+// GetGeneration retrieves a generation by ID or name, e.g. "1" or "generation-i".
+func (s *generationServiceImpl) GetGeneration(ctx context.Context, identifier string) (model.GenerationResponse, error) {
+	var generation model.GenerationDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		generation, err = s.generationRepo.GetGenerationByID(ctx, id)
+	} else {
+		generation, err = s.generationRepo.GetGenerationByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.GenerationResponse{}, err
+	}
+
+	versionGroupOrder, err := s.generationRepo.GetVersionGroupOrder(ctx)
+	if err != nil {
+		return model.GenerationResponse{}, err
+	}
+
+	return model.GenerationResponse{
+		ID:            generation.ID,
+		Name:          generation.Name,
+		DisplayName:   utils.EnglishText(generation.Names),
+		MainRegion:    generation.MainRegion.Name,
+		VersionGroups: sortByOrder(names(generation.VersionGroups), versionGroupOrder),
+		Introduced:    introducedIn(generation),
+	}, nil
+}
+
+func (s *generationServiceImpl) GetGenerationList(ctx context.Context, limit, offset int, baseUrl string) (model.GenerationListResponse, error) {
+	generations, totalCount, err := s.generationRepo.GetGenerationList(ctx, limit, offset)
+	if err != nil {
+		return model.GenerationListResponse{}, err
+	}
+
+	versionGroupOrder, err := s.generationRepo.GetVersionGroupOrder(ctx)
+	if err != nil {
+		return model.GenerationListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	listItems := make([]model.GenerationListItem, 0, len(generations))
+	for _, g := range generations {
+		listItems = append(listItems, model.GenerationListItem{
+			ID:            g.ID,
+			Name:          g.Name,
+			URL:           fmt.Sprintf("%s/%d", baseUrl, g.ID),
+			MainRegion:    g.MainRegion.Name,
+			VersionGroups: sortByOrder(names(g.VersionGroups), versionGroupOrder),
+		})
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.GenerationListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  listItems,
+	}, nil
+}
+
+// GetVersionGroup retrieves a version group by ID or name, together with its
+// versions and what its generation introduced.
+func (s *generationServiceImpl) GetVersionGroup(ctx context.Context, identifier string) (model.VersionGroupResponse, error) {
+	var versionGroup model.VersionGroupDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		versionGroup, err = s.generationRepo.GetVersionGroupByID(ctx, id)
+	} else {
+		versionGroup, err = s.generationRepo.GetVersionGroupByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.VersionGroupResponse{}, err
+	}
+
+	versionNames := names(versionGroup.Versions)
+	versionDetails, err := s.generationRepo.GetVersionsByName(ctx, versionNames)
+	if err != nil {
+		return model.VersionGroupResponse{}, err
+	}
+	versions := make([]model.VersionInfo, 0, len(versionNames))
+	for _, name := range versionNames {
+		versions = append(versions, model.VersionInfo{
+			Name:        name,
+			DisplayName: utils.EnglishText(versionDetails[name].Names),
+		})
+	}
+
+	res := model.VersionGroupResponse{
+		ID:               versionGroup.ID,
+		Name:             versionGroup.Name,
+		Order:            versionGroup.Order,
+		Generation:       versionGroup.Generation.Name,
+		Regions:          names(versionGroup.Regions),
+		Versions:         versions,
+		MoveLearnMethods: names(versionGroup.MoveLearnMethods),
+		Pokedexes:        names(versionGroup.Pokedexes),
+	}
+
+	// Generation boleh belum di-sync; introduced dibiarkan null
+	generation, err := s.generationRepo.GetGenerationByName(ctx, versionGroup.Generation.Name)
+	if err == nil {
+		introduced := introducedIn(generation)
+		res.Introduced = &introduced
+	} else if err.Error() != fmt.Sprintf("generation not found: %s", versionGroup.Generation.Name) {
+		return model.VersionGroupResponse{}, err
+	}
+
+	return res, nil
+}
+
+func (s *generationServiceImpl) GetVersionGroupList(ctx context.Context, limit, offset int, baseUrl string) (model.VersionGroupListResponse, error) {
+	versionGroups, totalCount, err := s.generationRepo.GetVersionGroupList(ctx, limit, offset)
+	if err != nil {
+		return model.VersionGroupListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	listItems := make([]model.VersionGroupListItem, 0, len(versionGroups))
+	for _, vg := range versionGroups {
+		listItems = append(listItems, model.VersionGroupListItem{
+			ID:         vg.ID,
+			Name:       vg.Name,
+			URL:        fmt.Sprintf("%s/%d", baseUrl, vg.ID),
+			Order:      vg.Order,
+			Generation: vg.Generation.Name,
+			Versions:   names(vg.Versions),
+		})
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.VersionGroupListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  listItems,
+	}, nil
+}
+
+// introducedIn lists what a generation introduced, each sorted by PokeAPI ID
+// (PokeAPI itself returns these lists in no particular order).
+func introducedIn(generation model.GenerationDetail) model.Introduced {
+	return model.Introduced{
+		PokemonSpecies: namesByID(generation.PokemonSpecies),
+		Moves:          namesByID(generation.Moves),
+		Abilities:      namesByID(generation.Abilities),
+		Types:          namesByID(generation.Types),
+	}
+}
+
+func names(refs []model.ResourceReference) []string {
+	result := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		result = append(result, ref.Name)
+	}
+	return result
+}
+
+func namesByID(refs []model.ResourceReference) []string {
+	sorted := make([]model.ResourceReference, len(refs))
+	copy(sorted, refs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return utils.ExtractIDFromURL(sorted[i].URL) < utils.ExtractIDFromURL(sorted[j].URL)
+	})
+	return names(sorted)
+}
+
+// sortByOrder sorts version group names by their release order; unknown names go last.
+func sortByOrder(versionGroups []string, order map[string]int) []string {
+	sort.SliceStable(versionGroups, func(i, j int) bool {
+		oi, iok := order[versionGroups[i]]
+		oj, jok := order[versionGroups[j]]
+		if iok != jok {
+			return iok
+		}
+		return oi < oj
+	})
+	return versionGroups
+}
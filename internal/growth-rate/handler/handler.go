@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/growth-rate/model"
+	"pokedex/internal/growth-rate/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GrowthRateHandler struct {
+	growthRateService service.GrowthRateService
+}
+
+func NewGrowthRateHandler(svc service.GrowthRateService) *GrowthRateHandler {
+	return &GrowthRateHandler{
+		growthRateService: svc,
+	}
+}
+
+func (h *GrowthRateHandler) GetGrowthRateList(c *gin.Context) {
+	limitStr := c.DefaultQuery("limit", "20")
+	offsetStr := c.DefaultQuery("offset", "0")
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	// Mendapatkan skema (http/https), host, dan path dasar dari request
+	scheme := "http"
+	if c.Request.TLS != nil { // Cek apakah koneksi menggunakan HTTPS
+		scheme = "https"
+	}
+	baseUrl := fmt.Sprintf("%s://%s/api/v1/growth-rate", scheme, c.Request.Host)
+
+	listResponse, err := h.growthRateService.GetGrowthRateList(ctx, limit, offset, baseUrl)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve growth rate list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *GrowthRateHandler) GetGrowthRateDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	growthRate, err := h.growthRateService.GetGrowthRate(ctx, identifier)
+	if err != nil {
+		// More robust error checking for "not found"
+		if err.Error() == fmt.Sprintf("growth rate not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve growth rate detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, growthRate)
+}
+
+// CalculateExperience handles GET /api/v1/pokemon/:identifier/experience?exp=... or ?level=...
+func (h *GrowthRateHandler) CalculateExperience(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	var query model.ExperienceQuery
+	for param, target := range map[string]**int{"exp": &query.Experience, "level": &query.Level} {
+		raw, ok := c.GetQuery(param)
+		if !ok {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s: %s", param, raw)})
+			return
+		}
+		*target = &value
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	result, err := h.growthRateService.CalculateExperience(ctx, identifier, query)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid experience query: ") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "pokemon not found: ") || strings.HasPrefix(err.Error(), "growth rate not found: ") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to calculate experience"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
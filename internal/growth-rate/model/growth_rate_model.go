@@ -0,0 +1,102 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type DescriptionEntry struct {
+	Description string            `json:"description" bson:"description"`
+	Language    ResourceReference `json:"language" bson:"language"`
+}
+
+func (d DescriptionEntry) LanguageName() string  { return d.Language.Name }
+func (d DescriptionEntry) LocalizedText() string { return d.Description }
+
+// GrowthRateLevel is the total experience a pokemon needs to reach Level.
+type GrowthRateLevel struct {
+	Level      int `json:"level" bson:"level"`
+	Experience int `json:"experience" bson:"experience"`
+}
+
+// GrowthRateDetail is a growth rate as returned by PokeAPI /growth-rate/{id}.
+type GrowthRateDetail struct {
+	ID             int                 `json:"id" bson:"id"`
+	Name           string              `json:"name" bson:"name"`
+	Formula        string              `json:"formula" bson:"formula"`
+	Descriptions   []DescriptionEntry  `json:"descriptions" bson:"descriptions"`
+	Levels         []GrowthRateLevel   `json:"levels" bson:"levels"`
+	PokemonSpecies []ResourceReference `json:"pokemon_species" bson:"pokemon_species"`
+}
+
+// GrowthRateResponse is what /api/v1/growth-rate/:identifier returns, with the full
+// level-to-experience table sorted by level.
+type GrowthRateResponse struct {
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	DisplayName   string            `json:"display_name"`
+	Formula       string            `json:"formula"`
+	Description   string            `json:"description"`
+	MaxExperience int               `json:"max_experience"`
+	SpeciesCount  int               `json:"species_count"`
+	Levels        []GrowthRateLevel `json:"levels"`
+}
+
+// GrowthRateListItem is one growth rate in the list, without its table.
+type GrowthRateListItem struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	DisplayName   string `json:"display_name"`
+	Formula       string `json:"formula"`
+	MaxExperience int    `json:"max_experience"`
+}
+
+// GrowthRateListResponse
+type GrowthRateListResponse struct {
+	Count    int                  `json:"count"`
+	Next     *string              `json:"next"`
+	Previous *string              `json:"previous"`
+	Results  []GrowthRateListItem `json:"results"`
+}
+
+// GrowthRateDocument is the structure to store in MongoDB
+type GrowthRateDocument struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty"`
+	GrowthRateID   int                 `bson:"id"`
+	Name           string              `bson:"name"`
+	Formula        string              `bson:"formula"`
+	Descriptions   []DescriptionEntry  `bson:"descriptions"`
+	Levels         []GrowthRateLevel   `bson:"levels"`
+	PokemonSpecies []ResourceReference `bson:"pokemon_species"`
+	LastSyncedAt   int64               `bson:"last_synced_at"`
+}
+
+// PokemonGrowthRate is a pokemon with the name of its species' growth rate.
+type PokemonGrowthRate struct {
+	PokemonID   int
+	PokemonName string
+	GrowthRate  string
+}
+
+// ExperienceQuery is the input of the experience calculator: either the
+// current total experience or a level, never both.
+type ExperienceQuery struct {
+	Experience *int
+	Level      *int
+}
+
+// ExperienceResponse is what /api/v1/pokemon/:identifier/experience returns.
+// Experience is the total experience: the given value, or the minimum needed
+// for the given level.
+type ExperienceResponse struct {
+	PokemonID             int    `json:"pokemon_id"`
+	PokemonName           string `json:"pokemon_name"`
+	GrowthRate            string `json:"growth_rate"`
+	Level                 int    `json:"level"`
+	Experience            int    `json:"experience"`
+	ExperienceToNextLevel int    `json:"experience_to_next_level"`
+	ExperienceToLevel100  int    `json:"experience_to_level_100"`
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/growth-rate/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	growthRateCollectionName     = "growth_rates"
+	pokemonCollectionName        = "pokemons"
+	pokemonSpeciesCollectionName = "pokemon-species"
+)
+
+// GrowthRateRepository defines the interface for persisting and retrieving GrowthRate data.
+type GrowthRateRepository interface {
+	SaveGrowthRate(ctx context.Context, growthRate model.GrowthRateDetail) error
+	GetGrowthRateByID(ctx context.Context, id int) (model.GrowthRateDetail, error)
+	GetGrowthRateByName(ctx context.Context, name string) (model.GrowthRateDetail, error)
+	GetGrowthRateList(ctx context.Context, limit, offset int) ([]model.GrowthRateDetail, int64, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+	GetPokemonGrowthRate(ctx context.Context, id int, name string) (model.PokemonGrowthRate, error)
+}
+
+// MongoGrowthRateRepository implements the GrowthRateRepository interface for MongoDB.
+type MongoGrowthRateRepository struct {
+	collection        *mongo.Collection
+	pokemonCollection *mongo.Collection
+	speciesCollection *mongo.Collection
+}
+
+// NewMongoGrowthRateRepository creates a new MongoDB repository for growth rates.
+func NewMongoGrowthRateRepository() *MongoGrowthRateRepository {
+	return &MongoGrowthRateRepository{
+		collection:        database.MongoDatabase.Collection(growthRateCollectionName),
+		pokemonCollection: database.MongoDatabase.Collection(pokemonCollectionName),
+		speciesCollection: database.MongoDatabase.Collection(pokemonSpeciesCollectionName),
+	}
+}
+
+// SaveGrowthRate saves a growth rate detail to MongoDB, upserting on 'id'.
+func (r *MongoGrowthRateRepository) SaveGrowthRate(ctx context.Context, growthRate model.GrowthRateDetail) error {
+	doc := model.GrowthRateDocument{
+		GrowthRateID:   growthRate.ID,
+		Name:           growthRate.Name,
+		Formula:        growthRate.Formula,
+		Descriptions:   growthRate.Descriptions,
+		Levels:         growthRate.Levels,
+		PokemonSpecies: growthRate.PokemonSpecies,
+		LastSyncedAt:   time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.GrowthRateID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save growth rate %s (ID: %d) to MongoDB: %w", growthRate.Name, growthRate.ID, err)
+	}
+	return nil
+}
+
+// GetGrowthRateByID retrieves a growth rate by its original PokeAPI ID from MongoDB.
+func (r *MongoGrowthRateRepository) GetGrowthRateByID(ctx context.Context, id int) (model.GrowthRateDetail, error) {
+	var doc model.GrowthRateDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.GrowthRateDetail{}, fmt.Errorf("growth rate not found: %d", id)
+		}
+		return model.GrowthRateDetail{}, fmt.Errorf("failed to retrieve growth rate by ID from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetGrowthRateByName retrieves a growth rate by its name from MongoDB.
+func (r *MongoGrowthRateRepository) GetGrowthRateByName(ctx context.Context, name string) (model.GrowthRateDetail, error) {
+	var doc model.GrowthRateDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.GrowthRateDetail{}, fmt.Errorf("growth rate not found: %s", name)
+		}
+		return model.GrowthRateDetail{}, fmt.Errorf("failed to retrieve growth rate by name from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetGrowthRateList returns a page of growth rates sorted by ID.
+func (r *MongoGrowthRateRepository) GetGrowthRateList(ctx context.Context, limit, offset int) ([]model.GrowthRateDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count growth rates in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual growth rate ID
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve growth rate list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var growthRateDocs []model.GrowthRateDocument
+	if err = cursor.All(ctx, &growthRateDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode growth rate list from DB: %w", err)
+	}
+
+	var growthRates []model.GrowthRateDetail
+	for _, doc := range growthRateDocs {
+		growthRates = append(growthRates, r.toDetail(doc))
+	}
+
+	return growthRates, totalCount, nil
+}
+
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored growth rate whose ID is in ids.
+func (r *MongoGrowthRateRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every growth rate that is not tombstoned.
+func (r *MongoGrowthRateRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every growth rate whose ID was not seen by a full sync.
+func (r *MongoGrowthRateRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// GetPokemonGrowthRate looks up a pokemon by ID (when id > 0) or by name and
+// returns the growth rate of its species.
+func (r *MongoGrowthRateRepository) GetPokemonGrowthRate(ctx context.Context, id int, name string) (model.PokemonGrowthRate, error) {
+	filter := bson.M{"name": name}
+	if id > 0 {
+		filter = bson.M{"id": id}
+	}
+
+	var pokemon struct {
+		ID      int                     `bson:"id"`
+		Name    string                  `bson:"name"`
+		Species model.ResourceReference `bson:"species"`
+	}
+	findOptions := options.FindOne().SetProjection(bson.M{"id": 1, "name": 1, "species": 1})
+	err := r.pokemonCollection.FindOne(ctx, tombstone.Active(filter), findOptions).Decode(&pokemon)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			if id > 0 {
+				return model.PokemonGrowthRate{}, fmt.Errorf("pokemon not found: %d", id)
+			}
+			return model.PokemonGrowthRate{}, fmt.Errorf("pokemon not found: %s", name)
+		}
+		return model.PokemonGrowthRate{}, fmt.Errorf("failed to retrieve pokemon from DB: %w", err)
+	}
+
+	var species struct {
+		GrowthRate model.ResourceReference `bson:"growth_rate"`
+	}
+	speciesOptions := options.FindOne().SetProjection(bson.M{"growth_rate": 1})
+	err = r.speciesCollection.FindOne(ctx, bson.M{"name": pokemon.Species.Name}, speciesOptions).Decode(&species)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.PokemonGrowthRate{}, fmt.Errorf("pokemon species not found: %s", pokemon.Species.Name)
+		}
+		return model.PokemonGrowthRate{}, fmt.Errorf("failed to retrieve pokemon species from DB: %w", err)
+	}
+
+	return model.PokemonGrowthRate{
+		PokemonID:   pokemon.ID,
+		PokemonName: pokemon.Name,
+		GrowthRate:  species.GrowthRate.Name,
+	}, nil
+}
+
+// toDetail converts a GrowthRateDocument to a model.GrowthRateDetail.
+func (r *MongoGrowthRateRepository) toDetail(doc model.GrowthRateDocument) model.GrowthRateDetail {
+	return model.GrowthRateDetail{
+		ID:             doc.GrowthRateID,
+		Name:           doc.Name,
+		Formula:        doc.Formula,
+		Descriptions:   doc.Descriptions,
+		Levels:         doc.Levels,
+		PokemonSpecies: doc.PokemonSpecies,
+	}
+}
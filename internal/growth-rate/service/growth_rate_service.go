@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pokedex/internal/growth-rate/model"
+	"pokedex/internal/growth-rate/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GrowthRateService defines the business logic for growth rates and experience calculations.
+type GrowthRateService interface {
+	SyncAllGrowthRates(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncGrowthRate(ctx context.Context, url string) error
+	GetGrowthRate(ctx context.Context, identifier string) (model.GrowthRateResponse, error)
+	GetGrowthRateList(ctx context.Context, limit, offset int, baseUrl string) (model.GrowthRateListResponse, error)
+	CalculateExperience(ctx context.Context, identifier string, query model.ExperienceQuery) (model.ExperienceResponse, error)
+}
+
+// growthRateServiceImpl implements the GrowthRateService interface.
+type growthRateServiceImpl struct {
+	growthRateRepo repository.GrowthRateRepository
+	pokeAPIClient  *pokeapi.Client
+}
+
+// NewGrowthRateService creates a new instance of GrowthRateService.
+func NewGrowthRateService(repo repository.GrowthRateRepository, api *pokeapi.Client) GrowthRateService {
+	return &growthRateServiceImpl{
+		growthRateRepo: repo,
+		pokeAPIClient:  api,
+	}
+}
+
+// SyncAllGrowthRates fetches all growth rates from PokeAPI and saves them to the repository.
+func (s *growthRateServiceImpl) SyncAllGrowthRates(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
+
+// SyncGrowthRate fetches a single growth rate by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *growthRateServiceImpl) SyncGrowthRate(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
+
+// syncResource describes how growth rates are listed, fetched and stored by the sync engine.
+func (s *growthRateServiceImpl) syncResource() syncer.Resource[model.GrowthRateDetail] {
+	return syncer.Resource[model.GrowthRateDetail]{
+		Name:         "growth-rate",
+		Endpoint:     "growth-rate",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchGrowthRateDetail,
+		Save:         s.growthRateRepo.SaveGrowthRate,
+		LastSyncedAt: s.growthRateRepo.GetLastSyncedAt,
+		ActiveNames:  s.growthRateRepo.GetActiveNames,
+		Reconcile:    s.growthRateRepo.ReconcileTombstones,
+	}
+}
+
+// GetGrowthRate retrieves a growth rate by ID or name, with its experience table sorted by level.
+func (s *growthRateServiceImpl) GetGrowthRate(ctx context.Context, identifier string) (model.GrowthRateResponse, error) {
+	growthRate, err := s.getGrowthRate(ctx, identifier)
+	if err != nil {
+		return model.GrowthRateResponse{}, err
+	}
+
+	levels := sortedLevels(growthRate.Levels)
+	res := model.GrowthRateResponse{
+		ID:            growthRate.ID,
+		Name:          growthRate.Name,
+		DisplayName:   utils.ConvertGrowthRate(growthRate.Name),
+		Formula:       growthRate.Formula,
+		MaxExperience: maxExperience(levels),
+		SpeciesCount:  len(growthRate.PokemonSpecies),
+		Levels:        levels,
+	}
+	res.Description = utils.EnglishText(growthRate.Descriptions)
+	return res, nil
+}
+
+func (s *growthRateServiceImpl) GetGrowthRateList(ctx context.Context, limit, offset int, baseUrl string) (model.GrowthRateListResponse, error) {
+	growthRates, totalCount, err := s.growthRateRepo.GetGrowthRateList(ctx, limit, offset)
+	if err != nil {
+		return model.GrowthRateListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	results := make([]model.GrowthRateListItem, 0, len(growthRates))
+	for _, g := range growthRates {
+		results = append(results, model.GrowthRateListItem{
+			ID:            g.ID,
+			Name:          g.Name,
+			DisplayName:   utils.ConvertGrowthRate(g.Name),
+			Formula:       g.Formula,
+			MaxExperience: maxExperience(sortedLevels(g.Levels)),
+		})
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.GrowthRateListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  results,
+	}, nil
+}
+
+// CalculateExperience resolves a pokemon's growth rate and converts the given
+// experience into a level, or the given level into its minimum experience.
+func (s *growthRateServiceImpl) CalculateExperience(ctx context.Context, identifier string, query model.ExperienceQuery) (model.ExperienceResponse, error) {
+	if (query.Experience == nil) == (query.Level == nil) {
+		return model.ExperienceResponse{}, fmt.Errorf("invalid experience query: pass either exp or level")
+	}
+
+	id, _ := strconv.Atoi(identifier)
+	pokemon, err := s.growthRateRepo.GetPokemonGrowthRate(ctx, id, strings.ToLower(identifier))
+	if err != nil {
+		return model.ExperienceResponse{}, err
+	}
+
+	growthRate, err := s.growthRateRepo.GetGrowthRateByName(ctx, pokemon.GrowthRate)
+	if err != nil {
+		return model.ExperienceResponse{}, err
+	}
+	levels := sortedLevels(growthRate.Levels)
+	if len(levels) == 0 {
+		return model.ExperienceResponse{}, fmt.Errorf("growth rate %s has no experience table", growthRate.Name)
+	}
+
+	res := model.ExperienceResponse{
+		PokemonID:   pokemon.PokemonID,
+		PokemonName: pokemon.PokemonName,
+		GrowthRate:  growthRate.Name,
+	}
+
+	maxLevel := levels[len(levels)-1].Level
+	if query.Level != nil {
+		if *query.Level < levels[0].Level || *query.Level > maxLevel {
+			return model.ExperienceResponse{}, fmt.Errorf("invalid experience query: level must be between %d and %d", levels[0].Level, maxLevel)
+		}
+		res.Level = *query.Level
+		res.Experience = experienceAt(levels, res.Level)
+	} else {
+		if *query.Experience < 0 {
+			return model.ExperienceResponse{}, fmt.Errorf("invalid experience query: exp must not be negative")
+		}
+		res.Experience = *query.Experience
+		res.Level = levelAt(levels, res.Experience)
+	}
+
+	if res.Level < maxLevel {
+		res.ExperienceToNextLevel = experienceAt(levels, res.Level+1) - res.Experience
+	}
+	res.ExperienceToLevel100 = max(maxExperience(levels)-res.Experience, 0)
+	return res, nil
+}
+
+func (s *growthRateServiceImpl) getGrowthRate(ctx context.Context, identifier string) (model.GrowthRateDetail, error) {
+	id, err := strconv.Atoi(identifier)
+	if err == nil {
+		return s.growthRateRepo.GetGrowthRateByID(ctx, id)
+	}
+	return s.growthRateRepo.GetGrowthRateByName(ctx, strings.ToLower(identifier))
+}
+
+// sortedLevels returns the experience table sorted by level.
+func sortedLevels(levels []model.GrowthRateLevel) []model.GrowthRateLevel {
+	sorted := make([]model.GrowthRateLevel, len(levels))
+	copy(sorted, levels)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Level < sorted[j].Level
+	})
+	return sorted
+}
+
+// maxExperience is the experience needed for the highest level of a sorted table.
+func maxExperience(levels []model.GrowthRateLevel) int {
+	if len(levels) == 0 {
+		return 0
+	}
+	return levels[len(levels)-1].Experience
+}
+
+// experienceAt returns the minimum experience of level in a sorted table.
+func experienceAt(levels []model.GrowthRateLevel, level int) int {
+	for _, l := range levels {
+		if l.Level == level {
+			return l.Experience
+		}
+	}
+	return 0
+}
+
+// levelAt returns the highest level whose minimum experience has been reached.
+func levelAt(levels []model.GrowthRateLevel, experience int) int {
+	level := levels[0].Level
+	for _, l := range levels {
+		if l.Experience > experience {
+			break
+		}
+		level = l.Level
+	}
+	return level
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/item/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ItemHandler struct {
+	itemService service.ItemService
+}
+
+func NewItemHandler(svc service.ItemService) *ItemHandler {
+	return &ItemHandler{
+		itemService: svc,
+	}
+}
+
+func (h *ItemHandler) GetItemList(c *gin.Context) {
+	limitStr := c.DefaultQuery("limit", "20")
+	offsetStr := c.DefaultQuery("offset", "0")
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	// Optional filter, e.g. ?category=evolution
+	category := strings.ToLower(c.Query("category"))
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	// Mendapatkan skema (http/https), host, dan path dasar dari request
+	scheme := "http"
+	if c.Request.TLS != nil { // Cek apakah koneksi menggunakan HTTPS
+		scheme = "https"
+	}
+	baseUrl := fmt.Sprintf("%s://%s/api/v1/item", scheme, c.Request.Host)
+
+	listResponse, err := h.itemService.GetItemList(ctx, limit, offset, category, baseUrl)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *ItemHandler) GetItemDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	item, err := h.itemService.GetItem(ctx, identifier)
+	if err != nil {
+		// More robust error checking for "not found"
+		if err.Error() == fmt.Sprintf("item not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve item detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
@@ -0,0 +1,103 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type EffectEntries struct {
+	Effect      string            `json:"effect" bson:"effect"`
+	ShortEffect string            `json:"short_effect" bson:"short_effect"`
+	Language    ResourceReference `json:"language" bson:"language"`
+}
+
+func (e EffectEntries) LanguageName() string  { return e.Language.Name }
+func (e EffectEntries) LocalizedText() string { return e.Effect }
+
+// FlavorTextEntries uses "text" like PokeAPI's item endpoint (not "flavor_text").
+type FlavorTextEntries struct {
+	Text         string            `json:"text" bson:"text"`
+	Language     ResourceReference `json:"language" bson:"language"`
+	VersionGroup ResourceReference `json:"version_group" bson:"version_group"`
+}
+
+func (e FlavorTextEntries) LanguageName() string  { return e.Language.Name }
+func (e FlavorTextEntries) LocalizedText() string { return e.Text }
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+type ItemSprites struct {
+	Default *string `json:"default" bson:"default"`
+}
+
+// ItemDetail is an item as returned by PokeAPI /item/{id}.
+type ItemDetail struct {
+	ID                int                 `json:"id" bson:"id"`
+	Name              string              `json:"name" bson:"name"`
+	Cost              int                 `json:"cost" bson:"cost"`
+	FlingPower        *int                `json:"fling_power" bson:"fling_power"`
+	FlingEffect       *ResourceReference  `json:"fling_effect" bson:"fling_effect"`
+	Attributes        []ResourceReference `json:"attributes" bson:"attributes"`
+	Category          ResourceReference   `json:"category" bson:"category"`
+	EffectEntries     []EffectEntries     `json:"effect_entries" bson:"effect_entries"`
+	FlavorTextEntries []FlavorTextEntries `json:"flavor_text_entries" bson:"flavor_text_entries"`
+	Names             []NameEntry         `json:"names" bson:"names"`
+	Sprites           ItemSprites         `json:"sprites" bson:"sprites"`
+}
+
+// ItemDetailResponse is what /api/v1/item/:identifier returns, and what
+// evolution steps embed for their item, held item and baby trigger item.
+type ItemDetailResponse struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Category    string   `json:"category"`
+	Cost        int      `json:"cost"`
+	FlingPower  *int     `json:"fling_power"`
+	FlingEffect string   `json:"fling_effect"`
+	Attributes  []string `json:"attributes"`
+	Effect      string   `json:"effect"`
+	ShortEffect string   `json:"short_effect"`
+	FlavorText  string   `json:"flavor_text"`
+	Sprite      string   `json:"sprite"`
+}
+
+// ItemListItem
+type ItemListItem struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Category string `json:"category"`
+	Cost     int    `json:"cost"`
+	Sprite   string `json:"sprite"`
+}
+
+// ItemListResponse
+type ItemListResponse struct {
+	Count    int            `json:"count"`
+	Next     *string        `json:"next"`
+	Previous *string        `json:"previous"`
+	Results  []ItemListItem `json:"results"`
+}
+
+// ItemDocument is the structure to store in MongoDB
+type ItemDocument struct {
+	ID                primitive.ObjectID  `bson:"_id,omitempty"`
+	ItemID            int                 `bson:"id"`
+	Name              string              `bson:"name"`
+	Cost              int                 `bson:"cost"`
+	FlingPower        *int                `bson:"fling_power"`
+	FlingEffect       *ResourceReference  `bson:"fling_effect"`
+	Attributes        []ResourceReference `bson:"attributes"`
+	Category          ResourceReference   `bson:"category"`
+	EffectEntries     []EffectEntries     `bson:"effect_entries"`
+	FlavorTextEntries []FlavorTextEntries `bson:"flavor_text_entries"`
+	Names             []NameEntry         `bson:"names"`
+	Sprites           ItemSprites         `bson:"sprites"`
+	LastSyncedAt      int64               `bson:"last_synced_at"`
+}
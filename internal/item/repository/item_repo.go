@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/item/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"pokedex/utils"
+)
+
+const itemCollectionName = "items"
+
+// ItemRepository defines the interface for persisting and retrieving Item data.
+type ItemRepository interface {
+	SaveItem(ctx context.Context, item model.ItemDetail) error
+	GetItemByID(ctx context.Context, id int) (model.ItemDetailResponse, error)
+	GetItemByName(ctx context.Context, name string) (model.ItemDetailResponse, error)
+	GetItemsByName(ctx context.Context, names []string) (map[string]model.ItemDetailResponse, error)
+	GetItemList(ctx context.Context, limit, offset int, category string) ([]model.ItemDetailResponse, int64, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+}
+
+// MongoItemRepository implements the ItemRepository interface for MongoDB.
+type MongoItemRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoItemRepository creates a new MongoDB repository for items.
+func NewMongoItemRepository() *MongoItemRepository {
+	return &MongoItemRepository{
+		collection: database.MongoDatabase.Collection(itemCollectionName),
+	}
+}
+
+// SaveItem saves an item detail to MongoDB, upserting on 'id'.
+func (r *MongoItemRepository) SaveItem(ctx context.Context, item model.ItemDetail) error {
+	doc := model.ItemDocument{
+		ItemID:            item.ID,
+		Name:              item.Name,
+		Cost:              item.Cost,
+		FlingPower:        item.FlingPower,
+		FlingEffect:       item.FlingEffect,
+		Attributes:        item.Attributes,
+		Category:          item.Category,
+		EffectEntries:     item.EffectEntries,
+		FlavorTextEntries: item.FlavorTextEntries,
+		Names:             item.Names,
+		Sprites:           item.Sprites,
+		LastSyncedAt:      time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.ItemID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save item %s (ID: %d) to MongoDB: %w", item.Name, item.ID, err)
+	}
+	return nil
+}
+
+// GetItemByID retrieves an item by its original PokeAPI ID from MongoDB.
+func (r *MongoItemRepository) GetItemByID(ctx context.Context, id int) (model.ItemDetailResponse, error) {
+	var doc model.ItemDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.ItemDetailResponse{}, fmt.Errorf("item not found: %d", id)
+		}
+		return model.ItemDetailResponse{}, fmt.Errorf("failed to retrieve item by ID from DB: %w", err)
+	}
+	return r.toDetailResponse(doc), nil
+}
+
+// GetItemByName retrieves an item by its name from MongoDB.
+func (r *MongoItemRepository) GetItemByName(ctx context.Context, name string) (model.ItemDetailResponse, error) {
+	var doc model.ItemDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.ItemDetailResponse{}, fmt.Errorf("item not found: %s", name)
+		}
+		return model.ItemDetailResponse{}, fmt.Errorf("failed to retrieve item by name from DB: %w", err)
+	}
+	return r.toDetailResponse(doc), nil
+}
+
+// GetItemsByName retrieves every stored item whose name is in names, keyed by name.
+// Items that are not synced yet are simply absent from the result.
+func (r *MongoItemRepository) GetItemsByName(ctx context.Context, names []string) (map[string]model.ItemDetailResponse, error) {
+	items := make(map[string]model.ItemDetailResponse)
+	if len(names) == 0 {
+		return items, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, tombstone.Active(bson.M{"name": bson.M{"$in": names}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve items from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []model.ItemDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode items from DB: %w", err)
+	}
+
+	for _, doc := range docs {
+		items[doc.Name] = r.toDetailResponse(doc)
+	}
+	return items, nil
+}
+
+// GetItemList returns a page of items sorted by ID, optionally limited to one category.
+func (r *MongoItemRepository) GetItemList(ctx context.Context, limit, offset int, category string) ([]model.ItemDetailResponse, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	if category != "" {
+		filter["category.name"] = category
+	}
+
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count items in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual item ID
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve item list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var itemDocs []model.ItemDocument
+	if err = cursor.All(ctx, &itemDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode item list from DB: %w", err)
+	}
+
+	var items []model.ItemDetailResponse
+	for _, doc := range itemDocs {
+		items = append(items, r.toDetailResponse(doc))
+	}
+
+	return items, totalCount, nil
+}
+
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored item whose ID is in ids.
+func (r *MongoItemRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every item that is not tombstoned.
+func (r *MongoItemRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every item whose ID was not seen by a full sync.
+func (r *MongoItemRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// toDetailResponse flattens an ItemDocument into the API response, using the English texts.
+func (r *MongoItemRepository) toDetailResponse(doc model.ItemDocument) model.ItemDetailResponse {
+	res := model.ItemDetailResponse{
+		ID:         doc.ItemID,
+		Name:       doc.Name,
+		Category:   doc.Category.Name,
+		Cost:       doc.Cost,
+		FlingPower: doc.FlingPower,
+		Attributes: make([]string, 0, len(doc.Attributes)),
+	}
+
+	if doc.FlingEffect != nil {
+		res.FlingEffect = doc.FlingEffect.Name
+	}
+	if doc.Sprites.Default != nil {
+		res.Sprite = *doc.Sprites.Default
+	}
+	for _, attr := range doc.Attributes {
+		res.Attributes = append(res.Attributes, attr.Name)
+	}
+	if entry, ok := utils.FindEnglish(doc.EffectEntries); ok {
+		res.Effect = entry.Effect
+		res.ShortEffect = entry.ShortEffect
+	}
+	// Entri flavor text diurutkan dari versi lama ke baru, ambil yang paling baru
+	res.FlavorText = utils.LastEnglishText(doc.FlavorTextEntries)
+
+	return res
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"pokedex/internal/item/model"
+	"pokedex/internal/item/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"strconv"
+	"strings"
+)
+
+// ItemService defines the business logic for Item operations.
+type ItemService interface {
+	SyncAllItems(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncItem(ctx context.Context, url string) error
+	GetItem(ctx context.Context, identifier string) (model.ItemDetailResponse, error)
+	GetItemList(ctx context.Context, limit, offset int, category, baseUrl string) (model.ItemListResponse, error)
+}
+
+// itemServiceImpl implements the ItemService interface.
+type itemServiceImpl struct {
+	itemRepo      repository.ItemRepository
+	pokeAPIClient *pokeapi.Client
+}
+
+// NewItemService creates a new instance of ItemService.
+func NewItemService(repo repository.ItemRepository, api *pokeapi.Client) ItemService {
+	return &itemServiceImpl{
+		itemRepo:      repo,
+		pokeAPIClient: api,
+	}
+}
+
+// SyncAllItems fetches all items from PokeAPI and saves them to the repository.
+func (s *itemServiceImpl) SyncAllItems(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
+
+// SyncItem fetches a single item by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *itemServiceImpl) SyncItem(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
+
+// syncResource describes how items are listed, fetched and stored by the sync engine.
+func (s *itemServiceImpl) syncResource() syncer.Resource[model.ItemDetail] {
+	return syncer.Resource[model.ItemDetail]{
+		Name:         "item",
+		Endpoint:     "item",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchItemDetail,
+		Save:         s.itemRepo.SaveItem,
+		LastSyncedAt: s.itemRepo.GetLastSyncedAt,
+		ActiveNames:  s.itemRepo.GetActiveNames,
+		Reconcile:    s.itemRepo.ReconcileTombstones,
+	}
+}
+
+// GetItem retrieves an item by ID or name from the repository.
+func (s *itemServiceImpl) GetItem(ctx context.Context, identifier string) (model.ItemDetailResponse, error) {
+	id, err := strconv.Atoi(identifier)
+	if err == nil {
+		return s.itemRepo.GetItemByID(ctx, id)
+	}
+	return s.itemRepo.GetItemByName(ctx, strings.ToLower(identifier))
+}
+
+func (s *itemServiceImpl) GetItemList(ctx context.Context, limit, offset int, category, baseUrl string) (model.ItemListResponse, error) {
+	items, totalCount, err := s.itemRepo.GetItemList(ctx, limit, offset, category)
+	if err != nil {
+		return model.ItemListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	listItems := make([]model.ItemListItem, 0, len(items))
+	for _, item := range items {
+		listItems = append(listItems, model.ItemListItem{
+			ID:       item.ID,
+			Name:     item.Name,
+			URL:      fmt.Sprintf("%s/%d", baseUrl, item.ID),
+			Category: item.Category,
+			Cost:     item.Cost,
+			Sprite:   item.Sprite,
+		})
+	}
+
+	// Filter kategori ikut dibawa ke URL next dan previous
+	filterQuery := ""
+	if category != "" {
+		filterQuery = "&category=" + url.QueryEscape(category)
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d%s", baseUrl, limit, offset+limit, filterQuery)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d%s", baseUrl, limit, prevOffset, filterQuery)
+		previousURL = &url
+	}
+
+	return model.ItemListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  listItems,
+	}, nil
+}
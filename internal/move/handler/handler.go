@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/move/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MoveHandler struct {
+	moveService service.MoveService
+}
+
+func NewMoveHandler(svc service.MoveService) *MoveHandler {
+	return &MoveHandler{
+		moveService: svc,
+	}
+}
+
+func (h *MoveHandler) GetMoveList(c *gin.Context) {
+	limitStr := c.DefaultQuery("limit", "20")
+	offsetStr := c.DefaultQuery("offset", "0")
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	// Mendapatkan skema (http/https), host, dan path dasar dari request
+	scheme := "http"
+	if c.Request.TLS != nil { // Cek apakah koneksi menggunakan HTTPS
+		scheme = "https"
+	}
+	baseUrl := fmt.Sprintf("%s://%s/api/v1/move", scheme, c.Request.Host)
+
+	listResponse, err := h.moveService.GetMoveList(ctx, limit, offset, baseUrl)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve move list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+func (h *MoveHandler) GetMoveDetail(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	move, err := h.moveService.GetMove(ctx, identifier)
+	if err != nil {
+		// More robust error checking for "not found"
+		if err.Error() == fmt.Sprintf("move not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve move detail"})
+		return
+	}
+
+	c.JSON(http.StatusOK, move)
+}
@@ -0,0 +1,113 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type EffectEntries struct {
+	Effect      string            `json:"effect" bson:"effect"`
+	ShortEffect string            `json:"short_effect" bson:"short_effect"`
+	Language    ResourceReference `json:"language" bson:"language"`
+}
+
+type FlavorTextEntries struct {
+	FlavorText   string            `json:"flavor_text" bson:"flavor_text"`
+	Language     ResourceReference `json:"language" bson:"language"`
+	VersionGroup ResourceReference `json:"version_group" bson:"version_group"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+// MoveMeta holds the battle metadata PokeAPI keeps for a move.
+// Nullable hit counts stay nil for moves that always hit once.
+type MoveMeta struct {
+	Ailment       ResourceReference `json:"ailment" bson:"ailment"`
+	Category      ResourceReference `json:"category" bson:"category"`
+	MinHits       *int              `json:"min_hits" bson:"min_hits"`
+	MaxHits       *int              `json:"max_hits" bson:"max_hits"`
+	MinTurns      *int              `json:"min_turns" bson:"min_turns"`
+	MaxTurns      *int              `json:"max_turns" bson:"max_turns"`
+	Drain         int               `json:"drain" bson:"drain"`
+	Healing       int               `json:"healing" bson:"healing"`
+	CritRate      int               `json:"crit_rate" bson:"crit_rate"`
+	AilmentChance int               `json:"ailment_chance" bson:"ailment_chance"`
+	FlinchChance  int               `json:"flinch_chance" bson:"flinch_chance"`
+	StatChance    int               `json:"stat_chance" bson:"stat_chance"`
+}
+
+// MoveDetail is a move as returned by PokeAPI /move/{id}.
+// Accuracy, power and PP are null in PokeAPI for moves that have none.
+type MoveDetail struct {
+	ID                int                 `json:"id" bson:"id"`
+	Name              string              `json:"name" bson:"name"`
+	Accuracy          *int                `json:"accuracy" bson:"accuracy"`
+	EffectChance      *int                `json:"effect_chance" bson:"effect_chance"`
+	PP                *int                `json:"pp" bson:"pp"`
+	Priority          int                 `json:"priority" bson:"priority"`
+	Power             *int                `json:"power" bson:"power"`
+	DamageClass       ResourceReference   `json:"damage_class" bson:"damage_class"`
+	Type              ResourceReference   `json:"type" bson:"type"`
+	Target            ResourceReference   `json:"target" bson:"target"`
+	Generation        ResourceReference   `json:"generation" bson:"generation"`
+	Meta              *MoveMeta           `json:"meta" bson:"meta"`
+	EffectEntries     []EffectEntries     `json:"effect_entries" bson:"effect_entries"`
+	FlavorTextEntries []FlavorTextEntries `json:"flavor_text_entries" bson:"flavor_text_entries"`
+	Names             []NameEntry         `json:"names" bson:"names"`
+}
+
+// MoveSummary is the part of a move that is shown next to it in other resources,
+// e.g. in the grouped moves of a pokemon.
+type MoveSummary struct {
+	Name        string `json:"name" bson:"name"`
+	Type        string `json:"type"`
+	Power       *int   `json:"power"`
+	DamageClass string `json:"damage_class"`
+}
+
+// MoveListItem
+type MoveListItem struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Type        string `json:"type"`
+	Power       *int   `json:"power"`
+	Accuracy    *int   `json:"accuracy"`
+	PP          *int   `json:"pp"`
+	DamageClass string `json:"damage_class"`
+}
+
+// MoveListResponse
+type MoveListResponse struct {
+	Count    int            `json:"count"`
+	Next     *string        `json:"next"`
+	Previous *string        `json:"previous"`
+	Results  []MoveListItem `json:"results"`
+}
+
+// MoveDocument is the structure to store in MongoDB
+type MoveDocument struct {
+	ID                primitive.ObjectID  `bson:"_id,omitempty"`
+	MoveID            int                 `bson:"id"`
+	Name              string              `bson:"name"`
+	Accuracy          *int                `bson:"accuracy"`
+	EffectChance      *int                `bson:"effect_chance"`
+	PP                *int                `bson:"pp"`
+	Priority          int                 `bson:"priority"`
+	Power             *int                `bson:"power"`
+	DamageClass       ResourceReference   `bson:"damage_class"`
+	Type              ResourceReference   `bson:"type"`
+	Target            ResourceReference   `bson:"target"`
+	Generation        ResourceReference   `bson:"generation"`
+	Meta              *MoveMeta           `bson:"meta"`
+	EffectEntries     []EffectEntries     `bson:"effect_entries"`
+	FlavorTextEntries []FlavorTextEntries `bson:"flavor_text_entries"`
+	Names             []NameEntry         `bson:"names"`
+	LastSyncedAt      int64               `bson:"last_synced_at"`
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/move/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MoveCollectionName is exported because the pokemon repository joins against it.
+const MoveCollectionName = "moves"
+
+// MoveRepository defines the interface for persisting and retrieving Move data.
+type MoveRepository interface {
+	SaveMove(ctx context.Context, move model.MoveDetail) error
+	GetMoveByID(ctx context.Context, id int) (model.MoveDetail, error)
+	GetMoveByName(ctx context.Context, name string) (model.MoveDetail, error)
+	GetMoveList(ctx context.Context, limit, offset int) ([]model.MoveDetail, int64, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+}
+
+// MongoMoveRepository implements the MoveRepository interface for MongoDB.
+type MongoMoveRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoMoveRepository creates a new MongoDB repository for moves.
+func NewMongoMoveRepository() *MongoMoveRepository {
+	return &MongoMoveRepository{
+		collection: database.MongoDatabase.Collection(MoveCollectionName),
+	}
+}
+
+// SaveMove saves a move detail to MongoDB, upserting on 'id'.
+func (r *MongoMoveRepository) SaveMove(ctx context.Context, move model.MoveDetail) error {
+	doc := model.MoveDocument{
+		MoveID:            move.ID,
+		Name:              move.Name,
+		Accuracy:          move.Accuracy,
+		EffectChance:      move.EffectChance,
+		PP:                move.PP,
+		Priority:          move.Priority,
+		Power:             move.Power,
+		DamageClass:       move.DamageClass,
+		Type:              move.Type,
+		Target:            move.Target,
+		Generation:        move.Generation,
+		Meta:              move.Meta,
+		EffectEntries:     move.EffectEntries,
+		FlavorTextEntries: move.FlavorTextEntries,
+		Names:             move.Names,
+		LastSyncedAt:      time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.MoveID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save move %s (ID: %d) to MongoDB: %w", move.Name, move.ID, err)
+	}
+	return nil
+}
+
+// GetMoveByID retrieves a move by its original PokeAPI ID from MongoDB.
+func (r *MongoMoveRepository) GetMoveByID(ctx context.Context, id int) (model.MoveDetail, error) {
+	var doc model.MoveDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.MoveDetail{}, fmt.Errorf("move not found: %d", id)
+		}
+		return model.MoveDetail{}, fmt.Errorf("failed to retrieve move by ID from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetMoveByName retrieves a move by its name from MongoDB.
+func (r *MongoMoveRepository) GetMoveByName(ctx context.Context, name string) (model.MoveDetail, error) {
+	var doc model.MoveDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.MoveDetail{}, fmt.Errorf("move not found: %s", name)
+		}
+		return model.MoveDetail{}, fmt.Errorf("failed to retrieve move by name from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+func (r *MongoMoveRepository) GetMoveList(ctx context.Context, limit, offset int) ([]model.MoveDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count moves in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual move ID
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve move list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var moveDocs []model.MoveDocument
+	if err = cursor.All(ctx, &moveDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode move list from DB: %w", err)
+	}
+
+	var moveDetails []model.MoveDetail
+	for _, doc := range moveDocs {
+		moveDetails = append(moveDetails, r.toDetail(doc))
+	}
+
+	return moveDetails, totalCount, nil
+}
+
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored move whose ID is in ids.
+func (r *MongoMoveRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every move that is not tombstoned.
+func (r *MongoMoveRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every move whose ID was not seen by a full sync.
+func (r *MongoMoveRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// toDetail converts a MoveDocument to a MoveDetail model.
+func (r *MongoMoveRepository) toDetail(doc model.MoveDocument) model.MoveDetail {
+	return model.MoveDetail{
+		ID:                doc.MoveID,
+		Name:              doc.Name,
+		Accuracy:          doc.Accuracy,
+		EffectChance:      doc.EffectChance,
+		PP:                doc.PP,
+		Priority:          doc.Priority,
+		Power:             doc.Power,
+		DamageClass:       doc.DamageClass,
+		Type:              doc.Type,
+		Target:            doc.Target,
+		Generation:        doc.Generation,
+		Meta:              doc.Meta,
+		EffectEntries:     doc.EffectEntries,
+		FlavorTextEntries: doc.FlavorTextEntries,
+		Names:             doc.Names,
+	}
+}
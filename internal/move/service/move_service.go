@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pokedex/internal/move/model"
+	"pokedex/internal/move/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"strconv"
+	"strings"
+)
+
+// MoveService defines the business logic for Move operations.
+type MoveService interface {
+	SyncAllMoves(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncMove(ctx context.Context, url string) error
+	GetMove(ctx context.Context, identifier string) (model.MoveDetail, error)
+	GetMoveList(ctx context.Context, limit, offset int, baseUrl string) (model.MoveListResponse, error)
+}
+
+// moveServiceImpl implements the MoveService interface.
+type moveServiceImpl struct {
+	moveRepo      repository.MoveRepository
+	pokeAPIClient *pokeapi.Client
+}
+
+// NewMoveService creates a new instance of MoveService.
+func NewMoveService(repo repository.MoveRepository, api *pokeapi.Client) MoveService {
+	return &moveServiceImpl{
+		moveRepo:      repo,
+		pokeAPIClient: api,
+	}
+}
+
+// SyncAllMoves fetches all moves from PokeAPI and saves them to the repository.
+func (s *moveServiceImpl) SyncAllMoves(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
+
+// SyncMove fetches a single move by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *moveServiceImpl) SyncMove(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
+
+// syncResource describes how moves are listed, fetched and stored by the sync engine.
+func (s *moveServiceImpl) syncResource() syncer.Resource[model.MoveDetail] {
+	return syncer.Resource[model.MoveDetail]{
+		Name:         "move",
+		Endpoint:     "move",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchMoveDetail,
+		Save:         s.moveRepo.SaveMove,
+		LastSyncedAt: s.moveRepo.GetLastSyncedAt,
+		ActiveNames:  s.moveRepo.GetActiveNames,
+		Reconcile:    s.moveRepo.ReconcileTombstones,
+	}
+}
+
+// GetMove retrieves a move by ID or name from the repository.
+func (s *moveServiceImpl) GetMove(ctx context.Context, identifier string) (model.MoveDetail, error) {
+	var move model.MoveDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		move, err = s.moveRepo.GetMoveByID(ctx, id)
+	} else {
+		move, err = s.moveRepo.GetMoveByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.MoveDetail{}, err
+	}
+
+	// PokeAPI menulis efek dengan placeholder "$effect_chance", isi dengan nilai aslinya
+	if move.EffectChance != nil {
+		chance := strconv.Itoa(*move.EffectChance)
+		for i := range move.EffectEntries {
+			move.EffectEntries[i].Effect = strings.ReplaceAll(move.EffectEntries[i].Effect, "$effect_chance", chance)
+			move.EffectEntries[i].ShortEffect = strings.ReplaceAll(move.EffectEntries[i].ShortEffect, "$effect_chance", chance)
+		}
+	}
+
+	return move, nil
+}
+
+func (s *moveServiceImpl) GetMoveList(ctx context.Context, limit, offset int, baseUrl string) (model.MoveListResponse, error) {
+	moves, totalCount, err := s.moveRepo.GetMoveList(ctx, limit, offset)
+	if err != nil {
+		return model.MoveListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	listItems := make([]model.MoveListItem, 0, len(moves))
+	for _, m := range moves {
+		listItems = append(listItems, model.MoveListItem{
+			ID:          m.ID,
+			Name:        m.Name,
+			URL:         fmt.Sprintf("%s/%d", baseUrl, m.ID),
+			Type:        m.Type.Name,
+			Power:       m.Power,
+			Accuracy:    m.Accuracy,
+			PP:          m.PP,
+			DamageClass: m.DamageClass.Name,
+		})
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.MoveListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  listItems,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package model
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+// NatureDetail is a nature as returned by PokeAPI /nature/{id}.
+// Neutral natures (hardy, docile, ...) raise and lower the same stat and like and hate
+// the same flavor, e.g. hardy is attack/attack and spicy/spicy.
+type NatureDetail struct {
+	ID            int                `json:"id" bson:"id"`
+	Name          string             `json:"name" bson:"name"`
+	IncreasedStat *ResourceReference `json:"increased_stat" bson:"increased_stat"`
+	DecreasedStat *ResourceReference `json:"decreased_stat" bson:"decreased_stat"`
+	LikesFlavor   *ResourceReference `json:"likes_flavor" bson:"likes_flavor"`
+	HatesFlavor   *ResourceReference `json:"hates_flavor" bson:"hates_flavor"`
+	Names         []NameEntry        `json:"names" bson:"names"`
+}
+
+// NatureResponse is what /api/v1/nature returns for a single nature.
+type NatureResponse struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	IncreasedStat string `json:"increased_stat"`
+	DecreasedStat string `json:"decreased_stat"`
+	LikesFlavor   string `json:"likes_flavor"`
+	HatesFlavor   string `json:"hates_flavor"`
+	IsNeutral     bool   `json:"is_neutral"`
+}
+
+// NatureListResponse
+type NatureListResponse struct {
+	Count    int              `json:"count"`
+	Next     *string          `json:"next"`
+	Previous *string          `json:"previous"`
+	Results  []NatureResponse `json:"results"`
+}
+
+// NatureDocument is the structure to store in MongoDB
+type NatureDocument struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	NatureID      int                `bson:"id"`
+	Name          string             `bson:"name"`
+	IncreasedStat *ResourceReference `bson:"increased_stat"`
+	DecreasedStat *ResourceReference `bson:"decreased_stat"`
+	LikesFlavor   *ResourceReference `bson:"likes_flavor"`
+	HatesFlavor   *ResourceReference `bson:"hates_flavor"`
+	Names         []NameEntry        `bson:"names"`
+	LastSyncedAt  int64              `bson:"last_synced_at"`
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/nature/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NatureCollectionName is shared with the pokemon repository, which reads
+// natures to compute nature-aware stat ranges.
+const NatureCollectionName = "natures"
+
+// NatureRepository defines the interface for persisting and retrieving Nature data.
+type NatureRepository interface {
+	SaveNature(ctx context.Context, nature model.NatureDetail) error
+	GetNatureByID(ctx context.Context, id int) (model.NatureDetail, error)
+	GetNatureByName(ctx context.Context, name string) (model.NatureDetail, error)
+	GetNatureList(ctx context.Context, limit, offset int) ([]model.NatureDetail, int64, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+}
+
+// MongoNatureRepository implements the NatureRepository interface for MongoDB.
+type MongoNatureRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoNatureRepository creates a new MongoDB repository for natures.
+func NewMongoNatureRepository() *MongoNatureRepository {
+	return &MongoNatureRepository{
+		collection: database.MongoDatabase.Collection(NatureCollectionName),
+	}
+}
+
+// SaveNature saves a nature detail to MongoDB, upserting on 'id'.
+func (r *MongoNatureRepository) SaveNature(ctx context.Context, nature model.NatureDetail) error {
+	doc := model.NatureDocument{
+		NatureID:      nature.ID,
+		Name:          nature.Name,
+		IncreasedStat: nature.IncreasedStat,
+		DecreasedStat: nature.DecreasedStat,
+		LikesFlavor:   nature.LikesFlavor,
+		HatesFlavor:   nature.HatesFlavor,
+		Names:         nature.Names,
+		LastSyncedAt:  time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.NatureID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save nature %s (ID: %d) to MongoDB: %w", nature.Name, nature.ID, err)
+	}
+	return nil
+}
+
+// GetNatureByID retrieves a nature by its original PokeAPI ID from MongoDB.
+func (r *MongoNatureRepository) GetNatureByID(ctx context.Context, id int) (model.NatureDetail, error) {
+	var doc model.NatureDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.NatureDetail{}, fmt.Errorf("nature not found: %d", id)
+		}
+		return model.NatureDetail{}, fmt.Errorf("failed to retrieve nature by ID from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetNatureByName retrieves a nature by its name from MongoDB.
+func (r *MongoNatureRepository) GetNatureByName(ctx context.Context, name string) (model.NatureDetail, error) {
+	var doc model.NatureDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.NatureDetail{}, fmt.Errorf("nature not found: %s", name)
+		}
+		return model.NatureDetail{}, fmt.Errorf("failed to retrieve nature by name from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetNatureList returns a page of natures sorted by ID.
+func (r *MongoNatureRepository) GetNatureList(ctx context.Context, limit, offset int) ([]model.NatureDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count natures in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual nature ID
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve nature list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var natureDocs []model.NatureDocument
+	if err = cursor.All(ctx, &natureDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode nature list from DB: %w", err)
+	}
+
+	var natures []model.NatureDetail
+	for _, doc := range natureDocs {
+		natures = append(natures, r.toDetail(doc))
+	}
+
+	return natures, totalCount, nil
+}
+
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored nature whose ID is in ids.
+func (r *MongoNatureRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every nature that is not tombstoned.
+func (r *MongoNatureRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every nature whose ID was not seen by a full sync.
+func (r *MongoNatureRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// toDetail converts a NatureDocument to a model.NatureDetail.
+func (r *MongoNatureRepository) toDetail(doc model.NatureDocument) model.NatureDetail {
+	return model.NatureDetail{
+		ID:            doc.NatureID,
+		Name:          doc.Name,
+		IncreasedStat: doc.IncreasedStat,
+		DecreasedStat: doc.DecreasedStat,
+		LikesFlavor:   doc.LikesFlavor,
+		HatesFlavor:   doc.HatesFlavor,
+		Names:         doc.Names,
+	}
+}
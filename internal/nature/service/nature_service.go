@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pokedex/internal/nature/model"
+	"pokedex/internal/nature/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"strconv"
+	"strings"
+)
+
+// NatureService defines the business logic for Nature operations.
+type NatureService interface {
+	SyncAllNatures(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncNature(ctx context.Context, url string) error
+	GetNature(ctx context.Context, identifier string) (model.NatureResponse, error)
+	GetNatureList(ctx context.Context, limit, offset int, baseUrl string) (model.NatureListResponse, error)
+}
+
+// natureServiceImpl implements the NatureService interface.
+type natureServiceImpl struct {
+	natureRepo    repository.NatureRepository
+	pokeAPIClient *pokeapi.Client
+}
+
+// NewNatureService creates a new instance of NatureService.
+func NewNatureService(repo repository.NatureRepository, api *pokeapi.Client) NatureService {
+	return &natureServiceImpl{
+		natureRepo:    repo,
+		pokeAPIClient: api,
+	}
+}
+
+// SyncAllNatures fetches all natures from PokeAPI and saves them to the repository.
+func (s *natureServiceImpl) SyncAllNatures(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
+
+// SyncNature fetches a single nature by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *natureServiceImpl) SyncNature(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
+
+// syncResource describes how natures are listed, fetched and stored by the sync engine.
+func (s *natureServiceImpl) syncResource() syncer.Resource[model.NatureDetail] {
+	return syncer.Resource[model.NatureDetail]{
+		Name:         "nature",
+		Endpoint:     "nature",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchNatureDetail,
+		Save:         s.natureRepo.SaveNature,
+		LastSyncedAt: s.natureRepo.GetLastSyncedAt,
+		ActiveNames:  s.natureRepo.GetActiveNames,
+		Reconcile:    s.natureRepo.ReconcileTombstones,
+	}
+}
+
+// GetNature retrieves a nature by ID or name from the repository.
+func (s *natureServiceImpl) GetNature(ctx context.Context, identifier string) (model.NatureResponse, error) {
+	var nature model.NatureDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		nature, err = s.natureRepo.GetNatureByID(ctx, id)
+	} else {
+		nature, err = s.natureRepo.GetNatureByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.NatureResponse{}, err
+	}
+
+	return toNatureResponse(nature), nil
+}
+
+func (s *natureServiceImpl) GetNatureList(ctx context.Context, limit, offset int, baseUrl string) (model.NatureListResponse, error) {
+	natures, totalCount, err := s.natureRepo.GetNatureList(ctx, limit, offset)
+	if err != nil {
+		return model.NatureListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	results := make([]model.NatureResponse, 0, len(natures))
+	for _, n := range natures {
+		results = append(results, toNatureResponse(n))
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.NatureListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  results,
+	}, nil
+}
+
+// toNatureResponse flattens the nullable stat and flavor references of a nature.
+func toNatureResponse(nature model.NatureDetail) model.NatureResponse {
+	res := model.NatureResponse{
+		ID:   nature.ID,
+		Name: nature.Name,
+	}
+	if nature.IncreasedStat != nil {
+		res.IncreasedStat = nature.IncreasedStat.Name
+	}
+	if nature.DecreasedStat != nil {
+		res.DecreasedStat = nature.DecreasedStat.Name
+	}
+	if nature.LikesFlavor != nil {
+		res.LikesFlavor = nature.LikesFlavor.Name
+	}
+	if nature.HatesFlavor != nil {
+		res.HatesFlavor = nature.HatesFlavor.Name
+	}
+	// Nature netral menaikkan dan menurunkan stat yang sama (atau tidak sama sekali)
+	res.IsNeutral = res.IncreasedStat == res.DecreasedStat
+	return res
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"pokedex/internal/pokedex/service"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PokedexHandler struct {
+	pokedexService service.PokedexService
+}
+
+func NewPokedexHandler(svc service.PokedexService) *PokedexHandler {
+	return &PokedexHandler{
+		pokedexService: svc,
+	}
+}
+
+func (h *PokedexHandler) GetPokedexList(c *gin.Context) {
+	limit, offset := pagination(c, "20")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	listResponse, err := h.pokedexService.GetPokedexList(ctx, limit, offset, baseURL(c, "pokedex"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokedex list"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+// GetPokedexEntries handles GET /api/v1/pokedex/:identifier, paginated like the pokemon list.
+func (h *PokedexHandler) GetPokedexEntries(c *gin.Context) {
+	identifier := c.Param("identifier")
+	limit, offset := pagination(c, "10")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	baseUrl := baseURL(c, "pokedex/"+identifier)
+	listResponse, err := h.pokedexService.GetPokedexEntries(ctx, identifier, limit, offset, baseUrl, baseURL(c, "pokemon"))
+	if err != nil {
+		if err.Error() == fmt.Sprintf("pokedex not found: %s", strings.ToLower(identifier)) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokedex entries"})
+		return
+	}
+
+	c.JSON(http.StatusOK, listResponse)
+}
+
+// pagination reads ?limit= and ?offset=; an invalid limit falls back to 20 like the pokemon list.
+func pagination(c *gin.Context, defaultLimit string) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", defaultLimit))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// baseURL builds the absolute URL of a v1 resource from the request's scheme and host.
+func baseURL(c *gin.Context, resource string) string {
+	// Mendapatkan skema (http/https), host, dan path dasar dari request
+	scheme := "http"
+	if c.Request.TLS != nil { // Cek apakah koneksi menggunakan HTTPS
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/api/v1/%s", scheme, c.Request.Host, resource)
+}
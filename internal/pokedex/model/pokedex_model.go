@@ -0,0 +1,97 @@
+package model
+
+import (
+	pokemon_model "pokedex/internal/pokemon/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+func (n NameEntry) LanguageName() string  { return n.Language.Name }
+func (n NameEntry) LocalizedText() string { return n.Name }
+
+type DescriptionEntry struct {
+	Description string            `json:"description" bson:"description"`
+	Language    ResourceReference `json:"language" bson:"language"`
+}
+
+func (d DescriptionEntry) LanguageName() string  { return d.Language.Name }
+func (d DescriptionEntry) LocalizedText() string { return d.Description }
+
+type PokemonEntry struct {
+	EntryNumber    int               `json:"entry_number" bson:"entry_number"`
+	PokemonSpecies ResourceReference `json:"pokemon_species" bson:"pokemon_species"`
+}
+
+// PokedexDetail is a pokedex as returned by PokeAPI /pokedex/{id}.
+type PokedexDetail struct {
+	ID             int                 `json:"id" bson:"id"`
+	Name           string              `json:"name" bson:"name"`
+	IsMainSeries   bool                `json:"is_main_series" bson:"is_main_series"`
+	Descriptions   []DescriptionEntry  `json:"descriptions" bson:"descriptions"`
+	Names          []NameEntry         `json:"names" bson:"names"`
+	PokemonEntries []PokemonEntry      `json:"pokemon_entries" bson:"pokemon_entries"`
+	Region         *ResourceReference  `json:"region" bson:"region"` // null untuk national dex
+	VersionGroups  []ResourceReference `json:"version_groups" bson:"version_groups"`
+}
+
+// PokedexInfo describes a pokedex in list and entry responses.
+type PokedexInfo struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"display_name"`
+	Description  string `json:"description"`
+	Region       string `json:"region"`
+	IsMainSeries bool   `json:"is_main_series"`
+	EntryCount   int    `json:"entry_count"`
+}
+
+// PokedexEntryItem is a pokemon listed in regional entry_number order.
+type PokedexEntryItem struct {
+	EntryNumber int                         `json:"entry_number"`
+	ID          int                         `json:"id"`
+	Name        string                      `json:"name"`
+	URL         string                      `json:"url"`
+	Types       []pokemon_model.PokemonType `json:"types"`
+	Thumbnail   string                      `json:"thumbnail"`
+}
+
+// PokedexEntryListResponse is paginated like PokemonListResponse.
+type PokedexEntryListResponse struct {
+	Pokedex  PokedexInfo        `json:"pokedex"`
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []PokedexEntryItem `json:"results"`
+}
+
+type PokedexListResponse struct {
+	Count    int           `json:"count"`
+	Next     *string       `json:"next"`
+	Previous *string       `json:"previous"`
+	Results  []PokedexInfo `json:"results"`
+}
+
+// PokedexDocument is the structure to store in MongoDB
+type PokedexDocument struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty"`
+	PokedexID      int                 `bson:"id"`
+	Name           string              `bson:"name"`
+	IsMainSeries   bool                `bson:"is_main_series"`
+	Descriptions   []DescriptionEntry  `bson:"descriptions"`
+	Names          []NameEntry         `bson:"names"`
+	PokemonEntries []PokemonEntry      `bson:"pokemon_entries"`
+	Region         *ResourceReference  `bson:"region"`
+	VersionGroups  []ResourceReference `bson:"version_groups"`
+	LastSyncedAt   int64               `bson:"last_synced_at"`
+}
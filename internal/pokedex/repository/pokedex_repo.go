@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/pokedex/model"
+	pokemon_model "pokedex/internal/pokemon/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const pokedexCollectionName = "pokedexes"
+const pokemonCollectionName = "pokemons"
+
+// PokedexRepository defines the interface for persisting and retrieving Pokedex data.
+type PokedexRepository interface {
+	SavePokedex(ctx context.Context, pokedex model.PokedexDetail) error
+	GetPokedexByID(ctx context.Context, id int) (model.PokedexDetail, error)
+	GetPokedexByName(ctx context.Context, name string) (model.PokedexDetail, error)
+	GetPokedexList(ctx context.Context, limit, offset int) ([]model.PokedexDetail, int64, error)
+	GetPokemonTypes(ctx context.Context, ids []int) (map[int][]pokemon_model.PokemonType, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+}
+
+// MongoPokedexRepository implements the PokedexRepository interface for MongoDB.
+type MongoPokedexRepository struct {
+	collection        *mongo.Collection
+	pokemonCollection *mongo.Collection
+}
+
+// NewMongoPokedexRepository creates a new MongoDB repository for pokedexes.
+func NewMongoPokedexRepository() *MongoPokedexRepository {
+	return &MongoPokedexRepository{
+		collection:        database.MongoDatabase.Collection(pokedexCollectionName),
+		pokemonCollection: database.MongoDatabase.Collection(pokemonCollectionName),
+	}
+}
+
+// SavePokedex saves a pokedex to MongoDB, upserting on 'id'.
+func (r *MongoPokedexRepository) SavePokedex(ctx context.Context, pokedex model.PokedexDetail) error {
+	doc := model.PokedexDocument{
+		PokedexID:      pokedex.ID,
+		Name:           pokedex.Name,
+		IsMainSeries:   pokedex.IsMainSeries,
+		Descriptions:   pokedex.Descriptions,
+		Names:          pokedex.Names,
+		PokemonEntries: pokedex.PokemonEntries,
+		Region:         pokedex.Region,
+		VersionGroups:  pokedex.VersionGroups,
+		LastSyncedAt:   time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.PokedexID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save pokedex %s (ID: %d) to MongoDB: %w", pokedex.Name, pokedex.ID, err)
+	}
+	return nil
+}
+
+// GetPokedexByID retrieves a pokedex by its original PokeAPI ID from MongoDB.
+func (r *MongoPokedexRepository) GetPokedexByID(ctx context.Context, id int) (model.PokedexDetail, error) {
+	var doc model.PokedexDocument
+	filter := tombstone.Active(bson.M{"id": id})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.PokedexDetail{}, fmt.Errorf("pokedex not found: %d", id)
+		}
+		return model.PokedexDetail{}, fmt.Errorf("failed to retrieve pokedex by ID from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetPokedexByName retrieves a pokedex by its name (e.g. "kanto") from MongoDB.
+func (r *MongoPokedexRepository) GetPokedexByName(ctx context.Context, name string) (model.PokedexDetail, error) {
+	var doc model.PokedexDocument
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.PokedexDetail{}, fmt.Errorf("pokedex not found: %s", name)
+		}
+		return model.PokedexDetail{}, fmt.Errorf("failed to retrieve pokedex by name from DB: %w", err)
+	}
+	return r.toDetail(doc), nil
+}
+
+// GetPokedexList returns a page of pokedexes sorted by ID.
+func (r *MongoPokedexRepository) GetPokedexList(ctx context.Context, limit, offset int) ([]model.PokedexDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count pokedexes in DB: %w", err)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetLimit(int64(limit))
+	findOptions.SetSkip(int64(offset))
+	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual pokedex ID
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to retrieve pokedex list from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var pokedexDocs []model.PokedexDocument
+	if err = cursor.All(ctx, &pokedexDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode pokedex list from DB: %w", err)
+	}
+
+	var pokedexes []model.PokedexDetail
+	for _, doc := range pokedexDocs {
+		pokedexes = append(pokedexes, r.toDetail(doc))
+	}
+
+	return pokedexes, totalCount, nil
+}
+
+// GetPokemonTypes returns the types of every stored pokemon whose ID is in ids.
+func (r *MongoPokedexRepository) GetPokemonTypes(ctx context.Context, ids []int) (map[int][]pokemon_model.PokemonType, error) {
+	filter := tombstone.Active(bson.M{"id": bson.M{"$in": ids}})
+	findOptions := options.Find().SetProjection(bson.M{"id": 1, "types": 1})
+
+	cursor, err := r.pokemonCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve pokemon types from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID    int                         `bson:"id"`
+		Types []pokemon_model.PokemonType `bson:"types"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode pokemon types from DB: %w", err)
+	}
+
+	types := make(map[int][]pokemon_model.PokemonType, len(docs))
+	for _, doc := range docs {
+		types[doc.ID] = doc.Types
+	}
+	return types, nil
+}
+
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored pokedex whose ID is in ids.
+func (r *MongoPokedexRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every pokedex that is not tombstoned.
+func (r *MongoPokedexRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every pokedex whose ID was not seen by a full sync.
+func (r *MongoPokedexRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// toDetail converts a PokedexDocument to a model.PokedexDetail.
+func (r *MongoPokedexRepository) toDetail(doc model.PokedexDocument) model.PokedexDetail {
+	return model.PokedexDetail{
+		ID:             doc.PokedexID,
+		Name:           doc.Name,
+		IsMainSeries:   doc.IsMainSeries,
+		Descriptions:   doc.Descriptions,
+		Names:          doc.Names,
+		PokemonEntries: doc.PokemonEntries,
+		Region:         doc.Region,
+		VersionGroups:  doc.VersionGroups,
+	}
+}
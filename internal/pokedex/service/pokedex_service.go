@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pokedex/internal/pokedex/model"
+	"pokedex/internal/pokedex/repository"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/spritemirror"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PokedexService defines the business logic for Pokedex operations.
+type PokedexService interface {
+	SyncAllPokedexes(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncPokedex(ctx context.Context, url string) error
+	GetPokedexList(ctx context.Context, limit, offset int, baseUrl string) (model.PokedexListResponse, error)
+	GetPokedexEntries(ctx context.Context, identifier string, limit, offset int, baseUrl, pokemonBaseUrl string) (model.PokedexEntryListResponse, error)
+}
+
+// pokedexServiceImpl implements the PokedexService interface.
+type pokedexServiceImpl struct {
+	pokedexRepo   repository.PokedexRepository
+	pokeAPIClient *pokeapi.Client
+}
+
+// NewPokedexService creates a new instance of PokedexService.
+func NewPokedexService(repo repository.PokedexRepository, api *pokeapi.Client) PokedexService {
+	return &pokedexServiceImpl{
+		pokedexRepo:   repo,
+		pokeAPIClient: api,
+	}
+}
+
+// SyncAllPokedexes fetches all pokedexes from PokeAPI and saves them to the repository.
+func (s *pokedexServiceImpl) SyncAllPokedexes(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
+
+// SyncPokedex fetches a single pokedex by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *pokedexServiceImpl) SyncPokedex(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
+
+// syncResource describes how pokedexes are listed, fetched and stored by the sync engine.
+func (s *pokedexServiceImpl) syncResource() syncer.Resource[model.PokedexDetail] {
+	return syncer.Resource[model.PokedexDetail]{
+		Name:         "pokedex",
+		Endpoint:     "pokedex",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchPokedexDetail,
+		Save:         s.pokedexRepo.SavePokedex,
+		LastSyncedAt: s.pokedexRepo.GetLastSyncedAt,
+		ActiveNames:  s.pokedexRepo.GetActiveNames,
+		Reconcile:    s.pokedexRepo.ReconcileTombstones,
+	}
+}
+
+func (s *pokedexServiceImpl) GetPokedexList(ctx context.Context, limit, offset int, baseUrl string) (model.PokedexListResponse, error) {
+	pokedexes, totalCount, err := s.pokedexRepo.GetPokedexList(ctx, limit, offset)
+	if err != nil {
+		return model.PokedexListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	results := make([]model.PokedexInfo, 0, len(pokedexes))
+	for _, p := range pokedexes {
+		results = append(results, toPokedexInfo(p))
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < int(totalCount) {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.PokedexListResponse{
+		Count:    int(totalCount),
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  results,
+	}, nil
+}
+
+// GetPokedexEntries lists one page of a pokedex in its own entry_number order,
+// with the same types and thumbnail as the pokemon list.
+func (s *pokedexServiceImpl) GetPokedexEntries(ctx context.Context, identifier string, limit, offset int, baseUrl, pokemonBaseUrl string) (model.PokedexEntryListResponse, error) {
+	var pokedex model.PokedexDetail
+	var err error
+
+	id, convErr := strconv.Atoi(identifier)
+	if convErr == nil {
+		pokedex, err = s.pokedexRepo.GetPokedexByID(ctx, id)
+	} else {
+		pokedex, err = s.pokedexRepo.GetPokedexByName(ctx, strings.ToLower(identifier))
+	}
+	if err != nil {
+		return model.PokedexEntryListResponse{}, err
+	}
+
+	entries := pokedex.PokemonEntries
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].EntryNumber < entries[j].EntryNumber
+	})
+
+	totalCount := len(entries)
+	start := min(offset, totalCount)
+	end := min(offset+limit, totalCount)
+	page := entries[start:end]
+
+	// ID species sama dengan ID pokemon default-nya, jadi types bisa diambil dari koleksi pokemons
+	ids := make([]int, 0, len(page))
+	for _, entry := range page {
+		ids = append(ids, utils.ExtractIDFromURL(entry.PokemonSpecies.URL))
+	}
+	types, err := s.pokedexRepo.GetPokemonTypes(ctx, ids)
+	if err != nil {
+		return model.PokedexEntryListResponse{}, err
+	}
+
+	// Ensure Results is an empty slice (not nil) if there are no items
+	results := make([]model.PokedexEntryItem, 0, len(page))
+	for i, entry := range page {
+		pokemonID := ids[i]
+		results = append(results, model.PokedexEntryItem{
+			EntryNumber: entry.EntryNumber,
+			ID:          pokemonID,
+			Name:        entry.PokemonSpecies.Name,
+			URL:         fmt.Sprintf("%s/%d", pokemonBaseUrl, pokemonID),
+			Types:       types[pokemonID],
+			Thumbnail:   spritemirror.URL(utils.GetThumbnailPokemon(pokemonID)),
+		})
+	}
+
+	// --- LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
+	var nextURL *string
+	var previousURL *string
+
+	// Next URL
+	if offset+limit < totalCount {
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, offset+limit)
+		nextURL = &url
+	}
+
+	// Previous URL
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0 // Pastikan offset tidak negatif
+		}
+		url := fmt.Sprintf("%s?limit=%d&offset=%d", baseUrl, limit, prevOffset)
+		previousURL = &url
+	}
+
+	return model.PokedexEntryListResponse{
+		Pokedex:  toPokedexInfo(pokedex),
+		Count:    totalCount,
+		Next:     nextURL,
+		Previous: previousURL,
+		Results:  results,
+	}, nil
+}
+
+func toPokedexInfo(pokedex model.PokedexDetail) model.PokedexInfo {
+	info := model.PokedexInfo{
+		ID:           pokedex.ID,
+		Name:         pokedex.Name,
+		IsMainSeries: pokedex.IsMainSeries,
+		EntryCount:   len(pokedex.PokemonEntries),
+	}
+	if pokedex.Region != nil {
+		info.Region = pokedex.Region.Name
+	}
+	info.DisplayName = utils.EnglishText(pokedex.Names)
+	info.Description = utils.EnglishText(pokedex.Descriptions)
+	return info
+}
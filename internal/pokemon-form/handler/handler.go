@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"pokedex/internal/pokemon-form/service"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PokemonFormHandler struct {
+	pokemonFormService service.PokemonFormService
+}
+
+func NewPokemonFormHandler(svc service.PokemonFormService) *PokemonFormHandler {
+	return &PokemonFormHandler{
+		pokemonFormService: svc,
+	}
+}
+
+// GetSpeciesVarieties handles GET /api/v1/pokemon-species/:identifier/varieties
+func (h *PokemonFormHandler) GetSpeciesVarieties(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	varieties, err := h.pokemonFormService.GetSpeciesVarieties(ctx, identifier)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "pokemon species not found: ") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokemon species varieties"})
+		return
+	}
+
+	c.JSON(http.StatusOK, varieties)
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	pokemon_model "pokedex/internal/pokemon/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ResourceReference represents a generic name and URL reference
+type ResourceReference struct {
+	Name string `json:"name" bson:"name"`
+	URL  string `json:"url" bson:"url"`
+}
+
+type NameEntry struct {
+	Language ResourceReference `json:"language" bson:"language"`
+	Name     string            `json:"name" bson:"name"`
+}
+
+func (n NameEntry) LanguageName() string  { return n.Language.Name }
+func (n NameEntry) LocalizedText() string { return n.Name }
+
+// PokemonFormSprites are the in-game sprites of a form; a missing sprite is null.
+type PokemonFormSprites struct {
+	FrontDefault *string `json:"front_default" bson:"front_default"`
+	FrontShiny   *string `json:"front_shiny" bson:"front_shiny"`
+	BackDefault  *string `json:"back_default" bson:"back_default"`
+	BackShiny    *string `json:"back_shiny" bson:"back_shiny"`
+}
+
+// PokemonFormDetail is a form as returned by PokeAPI /pokemon-form/{id}.
+type PokemonFormDetail struct {
+	ID           int                         `json:"id" bson:"id"`
+	Name         string                      `json:"name" bson:"name"`
+	Order        int                         `json:"order" bson:"order"`
+	FormOrder    int                         `json:"form_order" bson:"form_order"`
+	IsDefault    bool                        `json:"is_default" bson:"is_default"`
+	IsBattleOnly bool                        `json:"is_battle_only" bson:"is_battle_only"`
+	IsMega       bool                        `json:"is_mega" bson:"is_mega"`
+	FormName     string                      `json:"form_name" bson:"form_name"`
+	Pokemon      ResourceReference           `json:"pokemon" bson:"pokemon"`
+	Types        []pokemon_model.PokemonType `json:"types" bson:"types"`
+	Sprites      PokemonFormSprites          `json:"sprites" bson:"sprites"`
+	VersionGroup ResourceReference           `json:"version_group" bson:"version_group"`
+	Names        []NameEntry                 `json:"names" bson:"names"`
+	FormNames    []NameEntry                 `json:"form_names" bson:"form_names"`
+}
+
+// PokemonFormDocument is the structure to store in MongoDB
+type PokemonFormDocument struct {
+	ID           primitive.ObjectID          `bson:"_id,omitempty"`
+	FormID       int                         `bson:"id"`
+	Name         string                      `bson:"name"`
+	Order        int                         `bson:"order"`
+	FormOrder    int                         `bson:"form_order"`
+	IsDefault    bool                        `bson:"is_default"`
+	IsBattleOnly bool                        `bson:"is_battle_only"`
+	IsMega       bool                        `bson:"is_mega"`
+	FormName     string                      `bson:"form_name"`
+	Pokemon      ResourceReference           `bson:"pokemon"`
+	Types        []pokemon_model.PokemonType `bson:"types"`
+	Sprites      PokemonFormSprites          `bson:"sprites"`
+	VersionGroup ResourceReference           `bson:"version_group"`
+	Names        []NameEntry                 `bson:"names"`
+	FormNames    []NameEntry                 `bson:"form_names"`
+	LastSyncedAt int64                       `bson:"last_synced_at"`
+}
+
+// SpeciesVarieties holds the fields of a stored pokemon species that list its varieties.
+type SpeciesVarieties struct {
+	ID        int    `bson:"id"`
+	Name      string `bson:"name"`
+	Varieties []struct {
+		IsDefault bool              `bson:"is_default"`
+		Pokemon   ResourceReference `bson:"pokemon"`
+	} `bson:"varieties"`
+}
+
+// VarietyPokemon holds the fields of a stored pokemon that a variety response shows.
+type VarietyPokemon struct {
+	ID        int                         `bson:"id"`
+	Name      string                      `bson:"name"`
+	IsDefault bool                        `bson:"is_default"`
+	Types     []pokemon_model.PokemonType `bson:"types"`
+	Stats     []pokemon_model.PokemonStat `bson:"stats"`
+	Sprites   pokemon_model.Sprites       `bson:"sprites"`
+	Forms     []ResourceReference         `bson:"forms"`
+}
+
+// PokemonFormInfo is one form of a variety, e.g. one of Unown's letters.
+type PokemonFormInfo struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	FormName     string `json:"form_name"`
+	DisplayName  string `json:"display_name"`
+	IsDefault    bool   `json:"is_default"`
+	IsBattleOnly bool   `json:"is_battle_only"`
+	IsMega       bool   `json:"is_mega"`
+	VersionGroup string `json:"version_group"`
+	Sprite       string `json:"sprite"`
+}
+
+// PokemonVarietyResponse is one pokemon of a species, e.g. "raichu-alola" or "charizard-mega-x".
+// The form flags come from the variety's own default form.
+type PokemonVarietyResponse struct {
+	ID           int                         `json:"id"`
+	Name         string                      `json:"name"`
+	IsDefault    bool                        `json:"is_default"`
+	FormName     string                      `json:"form_name"`
+	IsMega       bool                        `json:"is_mega"`
+	IsGigantamax bool                        `json:"is_gigantamax"`
+	IsRegional   bool                        `json:"is_regional"`
+	IsBattleOnly bool                        `json:"is_battle_only"`
+	Types        []pokemon_model.PokemonType `json:"types"`
+	Stats        []pokemon_model.PokemonStat `json:"stats"`
+	Sprites      pokemon_model.Sprites       `json:"sprites"`
+	Thumbnail    string                      `json:"thumbnail"`
+	Forms        []PokemonFormInfo           `json:"forms"`
+}
+
+// SpeciesVarietiesResponse is what /api/v1/pokemon-species/:identifier/varieties returns.
+type SpeciesVarietiesResponse struct {
+	SpeciesID   int                      `json:"species_id"`
+	SpeciesName string                   `json:"species_name"`
+	Count       int                      `json:"count"`
+	Varieties   []PokemonVarietyResponse `json:"varieties"`
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"pokedex/database"
+	"pokedex/internal/pokemon-form/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	pokemonFormCollectionName    = "pokemon_forms"
+	pokemonCollectionName        = "pokemons"
+	pokemonSpeciesCollectionName = "pokemon-species"
+)
+
+// PokemonFormRepository defines the interface for persisting pokemon forms and
+// reading the species and pokemon they belong to.
+type PokemonFormRepository interface {
+	SavePokemonForm(ctx context.Context, form model.PokemonFormDetail) error
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+
+	GetSpeciesVarieties(ctx context.Context, id int, name string) (model.SpeciesVarieties, error)
+	GetPokemonsByName(ctx context.Context, names []string) (map[string]model.VarietyPokemon, error)
+	GetFormsByName(ctx context.Context, names []string) (map[string]model.PokemonFormDetail, error)
+}
+
+// MongoPokemonFormRepository implements the PokemonFormRepository interface for MongoDB.
+type MongoPokemonFormRepository struct {
+	collection        *mongo.Collection
+	pokemonCollection *mongo.Collection
+	speciesCollection *mongo.Collection
+}
+
+// NewMongoPokemonFormRepository creates a new MongoDB repository for pokemon forms.
+func NewMongoPokemonFormRepository() *MongoPokemonFormRepository {
+	return &MongoPokemonFormRepository{
+		collection:        database.MongoDatabase.Collection(pokemonFormCollectionName),
+		pokemonCollection: database.MongoDatabase.Collection(pokemonCollectionName),
+		speciesCollection: database.MongoDatabase.Collection(pokemonSpeciesCollectionName),
+	}
+}
+
+// SavePokemonForm saves a pokemon form to MongoDB, upserting on 'id'.
+func (r *MongoPokemonFormRepository) SavePokemonForm(ctx context.Context, form model.PokemonFormDetail) error {
+	doc := model.PokemonFormDocument{
+		FormID:       form.ID,
+		Name:         form.Name,
+		Order:        form.Order,
+		FormOrder:    form.FormOrder,
+		IsDefault:    form.IsDefault,
+		IsBattleOnly: form.IsBattleOnly,
+		IsMega:       form.IsMega,
+		FormName:     form.FormName,
+		Pokemon:      form.Pokemon,
+		Types:        form.Types,
+		Sprites:      form.Sprites,
+		VersionGroup: form.VersionGroup,
+		Names:        form.Names,
+		FormNames:    form.FormNames,
+		LastSyncedAt: time.Now().Unix(),
+	}
+
+	filter := bson.M{"id": doc.FormID}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save pokemon form %s (ID: %d) to MongoDB: %w", form.Name, form.ID, err)
+	}
+	return nil
+}
+
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored pokemon form whose ID is in ids.
+func (r *MongoPokemonFormRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every pokemon form that is not tombstoned.
+func (r *MongoPokemonFormRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every pokemon form whose ID was not seen by a full sync.
+func (r *MongoPokemonFormRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// GetSpeciesVarieties looks up a pokemon species by ID (when id > 0) or by name
+// and returns its list of varieties.
+func (r *MongoPokemonFormRepository) GetSpeciesVarieties(ctx context.Context, id int, name string) (model.SpeciesVarieties, error) {
+	filter := bson.M{"name": name}
+	if id > 0 {
+		filter = bson.M{"id": id}
+	}
+
+	var species model.SpeciesVarieties
+	findOptions := options.FindOne().SetProjection(bson.M{"id": 1, "name": 1, "varieties": 1})
+	err := r.speciesCollection.FindOne(ctx, filter, findOptions).Decode(&species)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			if id > 0 {
+				return model.SpeciesVarieties{}, fmt.Errorf("pokemon species not found: %d", id)
+			}
+			return model.SpeciesVarieties{}, fmt.Errorf("pokemon species not found: %s", name)
+		}
+		return model.SpeciesVarieties{}, fmt.Errorf("failed to retrieve pokemon species from DB: %w", err)
+	}
+	return species, nil
+}
+
+// GetPokemonsByName retrieves every stored pokemon whose name is in names, keyed by name.
+func (r *MongoPokemonFormRepository) GetPokemonsByName(ctx context.Context, names []string) (map[string]model.VarietyPokemon, error) {
+	pokemons := make(map[string]model.VarietyPokemon)
+	if len(names) == 0 {
+		return pokemons, nil
+	}
+
+	findOptions := options.Find().SetProjection(bson.M{
+		"id": 1, "name": 1, "is_default": 1, "types": 1, "stats": 1, "sprites": 1, "forms": 1,
+	})
+	cursor, err := r.pokemonCollection.Find(ctx, tombstone.Active(bson.M{"name": bson.M{"$in": names}}), findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve pokemons from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []model.VarietyPokemon
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode pokemons from DB: %w", err)
+	}
+
+	for _, doc := range docs {
+		pokemons[doc.Name] = doc
+	}
+	return pokemons, nil
+}
+
+// GetFormsByName retrieves every stored pokemon form whose name is in names, keyed by name.
+// Forms that are not synced yet are simply absent from the result.
+func (r *MongoPokemonFormRepository) GetFormsByName(ctx context.Context, names []string) (map[string]model.PokemonFormDetail, error) {
+	forms := make(map[string]model.PokemonFormDetail)
+	if len(names) == 0 {
+		return forms, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, tombstone.Active(bson.M{"name": bson.M{"$in": names}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve pokemon forms from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []model.PokemonFormDetail
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode pokemon forms from DB: %w", err)
+	}
+
+	for _, doc := range docs {
+		forms[doc.Name] = doc
+	}
+	return forms, nil
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"pokedex/internal/pokemon-form/model"
+	"pokedex/internal/pokemon-form/repository"
+	pokemon_model "pokedex/internal/pokemon/model"
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/spritemirror"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Form name dengan prefix ini adalah bentuk regional (mis. "galar-standard")
+var regionalFormPrefixes = []string{"alola", "galar", "hisui", "paldea"}
+
+// PokemonFormService defines the business logic for pokemon forms and species varieties.
+type PokemonFormService interface {
+	SyncAllPokemonForms(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncPokemonForm(ctx context.Context, url string) error
+	GetSpeciesVarieties(ctx context.Context, identifier string) (model.SpeciesVarietiesResponse, error)
+}
+
+// pokemonFormServiceImpl implements the PokemonFormService interface.
+type pokemonFormServiceImpl struct {
+	pokemonFormRepo repository.PokemonFormRepository
+	pokeAPIClient   *pokeapi.Client
+}
+
+// NewPokemonFormService creates a new instance of PokemonFormService.
+func NewPokemonFormService(repo repository.PokemonFormRepository, api *pokeapi.Client) PokemonFormService {
+	return &pokemonFormServiceImpl{
+		pokemonFormRepo: repo,
+		pokeAPIClient:   api,
+	}
+}
+
+// SyncAllPokemonForms fetches all pokemon forms from PokeAPI and saves them to the repository.
+func (s *pokemonFormServiceImpl) SyncAllPokemonForms(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
+
+// SyncPokemonForm fetches a single pokemon form by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *pokemonFormServiceImpl) SyncPokemonForm(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
+
+// syncResource describes how pokemon forms are listed, fetched and stored by the sync engine.
+func (s *pokemonFormServiceImpl) syncResource() syncer.Resource[model.PokemonFormDetail] {
+	return syncer.Resource[model.PokemonFormDetail]{
+		Name:         "pokemon-form",
+		Endpoint:     "pokemon-form",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchPokemonFormDetail,
+		Save:         s.pokemonFormRepo.SavePokemonForm,
+		LastSyncedAt: s.pokemonFormRepo.GetLastSyncedAt,
+		ActiveNames:  s.pokemonFormRepo.GetActiveNames,
+		Reconcile:    s.pokemonFormRepo.ReconcileTombstones,
+	}
+}
+
+// GetSpeciesVarieties returns every variety of a species (default first, then by
+// ID) with its types, stats, sprites, form flags and forms.
+func (s *pokemonFormServiceImpl) GetSpeciesVarieties(ctx context.Context, identifier string) (model.SpeciesVarietiesResponse, error) {
+	id, _ := strconv.Atoi(identifier)
+	species, err := s.pokemonFormRepo.GetSpeciesVarieties(ctx, id, strings.ToLower(identifier))
+	if err != nil {
+		return model.SpeciesVarietiesResponse{}, err
+	}
+
+	pokemonNames := make([]string, 0, len(species.Varieties))
+	for _, v := range species.Varieties {
+		pokemonNames = append(pokemonNames, v.Pokemon.Name)
+	}
+	pokemons, err := s.pokemonFormRepo.GetPokemonsByName(ctx, pokemonNames)
+	if err != nil {
+		return model.SpeciesVarietiesResponse{}, err
+	}
+
+	var formNames []string
+	for _, p := range pokemons {
+		for _, f := range p.Forms {
+			formNames = append(formNames, f.Name)
+		}
+	}
+	forms, err := s.pokemonFormRepo.GetFormsByName(ctx, formNames)
+	if err != nil {
+		return model.SpeciesVarietiesResponse{}, err
+	}
+
+	varieties := make([]model.PokemonVarietyResponse, 0, len(species.Varieties))
+	for _, v := range species.Varieties {
+		pokemon, ok := pokemons[v.Pokemon.Name]
+		if !ok {
+			// Pokemon belum di-sync, tampilkan data dari referensi species saja
+			pokemon = model.VarietyPokemon{
+				ID:        utils.ExtractIDFromURL(v.Pokemon.URL),
+				Name:      v.Pokemon.Name,
+				IsDefault: v.IsDefault,
+			}
+		}
+		varieties = append(varieties, toVarietyResponse(species.Name, pokemon, forms))
+	}
+	sort.SliceStable(varieties, func(i, j int) bool {
+		if varieties[i].IsDefault != varieties[j].IsDefault {
+			return varieties[i].IsDefault
+		}
+		return varieties[i].ID < varieties[j].ID
+	})
+
+	return model.SpeciesVarietiesResponse{
+		SpeciesID:   species.ID,
+		SpeciesName: species.Name,
+		Count:       len(varieties),
+		Varieties:   varieties,
+	}, nil
+}
+
+// toVarietyResponse builds one variety. Its form flags come from its default
+// form; when forms are not synced yet they are derived from the pokemon name.
+func toVarietyResponse(speciesName string, pokemon model.VarietyPokemon, forms map[string]model.PokemonFormDetail) model.PokemonVarietyResponse {
+	res := model.PokemonVarietyResponse{
+		ID:        pokemon.ID,
+		Name:      pokemon.Name,
+		IsDefault: pokemon.IsDefault,
+		Types:     pokemon.Types,
+		Stats:     pokemon.Stats,
+		Sprites:   pokemon.Sprites,
+		Thumbnail: pokemon.Sprites.Thumbnail(pokemon.ID),
+		Forms:     make([]model.PokemonFormInfo, 0, len(pokemon.Forms)),
+		FormName:  strings.TrimPrefix(strings.TrimPrefix(pokemon.Name, speciesName), "-"),
+	}
+	res.Sprites.Versions = nil // Sprite per game tersedia lewat /pokemon/:identifier/sprites
+	res.Sprites = res.Sprites.Mirrored()
+	if res.Types == nil {
+		res.Types = make([]pokemon_model.PokemonType, 0)
+	}
+	if res.Stats == nil {
+		res.Stats = make([]pokemon_model.PokemonStat, 0)
+	}
+
+	var varietyForm *model.PokemonFormDetail
+	for _, ref := range pokemon.Forms {
+		form, ok := forms[ref.Name]
+		if !ok {
+			continue
+		}
+		if varietyForm == nil || form.IsDefault {
+			varietyForm = &form
+		}
+		res.Forms = append(res.Forms, toFormInfo(form))
+	}
+	sort.SliceStable(res.Forms, func(i, j int) bool {
+		return res.Forms[i].ID < res.Forms[j].ID
+	})
+
+	if varietyForm != nil {
+		res.FormName = varietyForm.FormName
+		res.IsMega = varietyForm.IsMega
+		res.IsBattleOnly = varietyForm.IsBattleOnly
+	} else {
+		res.IsMega = res.FormName == "mega" || strings.HasPrefix(res.FormName, "mega-")
+	}
+	res.IsGigantamax = res.FormName == "gmax" || strings.HasSuffix(res.FormName, "-gmax")
+	for _, prefix := range regionalFormPrefixes {
+		if res.FormName == prefix || strings.HasPrefix(res.FormName, prefix+"-") {
+			res.IsRegional = true
+			break
+		}
+	}
+	return res
+}
+
+func toFormInfo(form model.PokemonFormDetail) model.PokemonFormInfo {
+	info := model.PokemonFormInfo{
+		ID:           form.ID,
+		Name:         form.Name,
+		FormName:     form.FormName,
+		IsDefault:    form.IsDefault,
+		IsBattleOnly: form.IsBattleOnly,
+		IsMega:       form.IsMega,
+		VersionGroup: form.VersionGroup.Name,
+	}
+	info.DisplayName = utils.EnglishText(form.FormNames)
+	if form.Sprites.FrontDefault != nil {
+		info.Sprite = spritemirror.URL(*form.Sprites.FrontDefault)
+	}
+	return info
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"pokedex/internal/pokemon-species/model"
 	"pokedex/internal/pokemon-species/service"
 
 	"github.com/gin-gonic/gin"
@@ -42,3 +44,37 @@ func (h *PokemonSpeciesHandler) GetPokemonSpeciesDetail(c *gin.Context) {
 
 	c.JSON(http.StatusOK, pokemon)
 }
+
+// GetDexEntries handles GET /api/v1/pokemon-species/:identifier/dex-entries?lang=en&version=red,blue
+func (h *PokemonSpeciesHandler) GetDexEntries(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	query := model.DexEntriesQuery{
+		Language: strings.TrimSpace(c.Query("lang")),
+	}
+	for _, name := range strings.Split(c.Query("version"), ",") {
+		if name = strings.TrimSpace(strings.ToLower(name)); name != "" {
+			query.Versions = append(query.Versions, name)
+		}
+	}
+
+	entries, err := h.pokemonSpeciesService.GetDexEntries(ctx, identifier, query)
+	if err != nil {
+		// Query parameter yang tidak dikenal adalah kesalahan dari client
+		if strings.HasPrefix(err.Error(), "version not found: ") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "pokemon not found: ") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokemon species dex entries"})
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
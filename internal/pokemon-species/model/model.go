@@ -23,11 +23,11 @@ type PokemonSpeciesDetail struct {
 	EggGroups            []ResourceReference `json:"egg_groups"`
 	EvolutionChain       ResourceReference   `json:"evolution_chain"`
 	EvolvesFromSpecies   *ResourceReference  `json:"evolves_from_species,omitempty"` // Use pointer for null
-	FlavorTextEntries    []interface{}       `json:"flavor_text_entries"`
-	FormDescriptions     []interface{}       `json:"form_descriptions"`
+	FlavorTextEntries    []FlavorTextEntry   `json:"flavor_text_entries"`
+	FormDescriptions     []FormDescription   `json:"form_descriptions"`
 	FormsSwitchable      bool                `json:"forms_switchable"`
 	GenderRate           int                 `json:"gender_rate"`
-	Genera               []interface{}       `json:"genera"`
+	Genera               []Genus             `json:"genera"`
 	Generation           ResourceReference   `json:"generation"`
 	GrowthRate           ResourceReference   `json:"growth_rate"`
 	Habitat              ResourceReference   `json:"habitat"`
@@ -55,11 +55,11 @@ type PokemonSpeciesDocument struct {
 	EggGroups            []ResourceReference `json:"egg_groups" bson:"egg_groups"`
 	EvolutionChain       ResourceReference   `json:"evolution_chain" bson:"evolution_chain"`
 	EvolvesFromSpecies   *ResourceReference  `json:"evolves_from_species" bson:"evolves_from_species,omitempty"` // Use pointer for null
-	FlavorTextEntries    []interface{}       `json:"flavor_text_entries" bson:"flavor_text_entries"`
-	FormDescriptions     []interface{}       `json:"form_descriptions" bson:"form_descriptions"`
+	FlavorTextEntries    []FlavorTextEntry   `json:"flavor_text_entries" bson:"flavor_text_entries"`
+	FormDescriptions     []FormDescription   `json:"form_descriptions" bson:"form_descriptions"`
 	FormsSwitchable      bool                `json:"forms_switchable" bson:"forms_switchable"`
 	GenderRate           int                 `json:"gender_rate" bson:"gender_rate"`
-	Genera               []interface{}       `json:"genera" bson:"genera"`
+	Genera               []Genus             `json:"genera" bson:"genera"`
 	Generation           ResourceReference   `json:"generation" bson:"generation"`
 	GrowthRate           ResourceReference   `json:"growth_rate" bson:"growth_rate"`
 	Habitat              ResourceReference   `json:"habitat" bson:"habitat"`
@@ -105,3 +105,46 @@ type PokemonNumber struct {
 	EntryNumber int               `json:"entry_number" bson:"entry_number"`
 	Pokedex     ResourceReference `json:"pokedex" bson:"pokedex"`
 }
+
+// FlavorTextEntry is the pokedex entry of a species in one language and game version.
+type FlavorTextEntry struct {
+	FlavorText string            `json:"flavor_text" bson:"flavor_text"`
+	Language   ResourceReference `json:"language" bson:"language"`
+	Version    ResourceReference `json:"version" bson:"version"`
+}
+
+// FormDescription describes the forms of a species in one language.
+type FormDescription struct {
+	Description string            `json:"description" bson:"description"`
+	Language    ResourceReference `json:"language" bson:"language"`
+}
+
+// Genus is the category of a species in one language, e.g. "Seed Pokémon".
+type Genus struct {
+	Genus    string            `json:"genus" bson:"genus"`
+	Language ResourceReference `json:"language" bson:"language"`
+}
+
+func (g Genus) LanguageName() string  { return g.Language.Name }
+func (g Genus) LocalizedText() string { return g.Genus }
+
+// DexEntriesQuery holds the optional ?lang= and ?version= of the dex entries endpoint.
+type DexEntriesQuery struct {
+	Language string   // Default "en"
+	Versions []string // Empty returns the entries of every version
+}
+
+type DexEntry struct {
+	Version    string `json:"version"`
+	FlavorText string `json:"flavor_text"`
+}
+
+// DexEntriesResponse is the response of /api/v1/pokemon-species/:identifier/dex-entries.
+type DexEntriesResponse struct {
+	ID       int        `json:"id"`
+	Name     string     `json:"name"`
+	Language string     `json:"language"`
+	Genus    string     `json:"genus"`
+	Count    int        `json:"count"`
+	Entries  []DexEntry `json:"entries"`
+}
@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"pokedex/database"
+	generation_repo "pokedex/internal/generation/repository"
 	"pokedex/internal/pokemon-species/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -19,15 +22,19 @@ type PokemonSpeciesRepository interface {
 	SavePokemonSpecies(ctx context.Context, pokemon model.PokemonSpeciesDetail) error
 	GetPokemonSpeciesByID(ctx context.Context, id int) (model.PokemonSpeciesDetail, error)
 	GetPokemonSpeciesByName(ctx context.Context, name string) (model.PokemonSpeciesDetail, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetVersionNames(ctx context.Context) (map[string]bool, error)
 }
 
 type MongoPokemonSpeciesRepository struct {
-	collection *mongo.Collection
+	collection         *mongo.Collection
+	collectionVersions *mongo.Collection
 }
 
 func NewMongoPokemonSpeciesRepository() *MongoPokemonSpeciesRepository {
 	return &MongoPokemonSpeciesRepository{
-		collection: database.MongoDatabase.Collection(pokemonSpeciesCollectionName),
+		collection:         database.MongoDatabase.Collection(pokemonSpeciesCollectionName),
+		collectionVersions: database.MongoDatabase.Collection(generation_repo.VersionCollectionName),
 	}
 }
 
@@ -102,6 +109,35 @@ func (r *MongoPokemonSpeciesRepository) GetPokemonSpeciesByName(ctx context.Cont
 	return r.toDetail(doc), nil
 }
 
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored pokemon species whose ID is in ids.
+func (r *MongoPokemonSpeciesRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetVersionNames returns the name of every synced game version. It is empty
+// until cmd/generation-sync has run.
+func (r *MongoPokemonSpeciesRepository) GetVersionNames(ctx context.Context) (map[string]bool, error) {
+	findOptions := options.Find().SetProjection(bson.M{"name": 1})
+	cursor, err := r.collectionVersions.Find(ctx, bson.M{}, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve versions from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		Name string `bson:"name"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode versions from DB: %w", err)
+	}
+
+	names := make(map[string]bool, len(docs))
+	for _, doc := range docs {
+		names[doc.Name] = true
+	}
+	return names, nil
+}
+
 func (r *MongoPokemonSpeciesRepository) toDetail(doc model.PokemonSpeciesDocument) model.PokemonSpeciesDetail {
 	return model.PokemonSpeciesDetail{
 		PokeAPIID:            doc.PokeAPIID,
@@ -112,8 +148,8 @@ func (r *MongoPokemonSpeciesRepository) toDetail(doc model.PokemonSpeciesDocumen
 		EggGroups:            doc.EggGroups,
 		EvolutionChain:       doc.EvolutionChain,
 		EvolvesFromSpecies:   doc.EvolvesFromSpecies,
-		FlavorTextEntries:    doc.FlavorTextEntries,
-		FormDescriptions:     doc.FormDescriptions,
+		FlavorTextEntries:    normalizeFlavorTexts(doc.FlavorTextEntries),
+		FormDescriptions:     normalizeFormDescriptions(doc.FormDescriptions),
 		FormsSwitchable:      doc.FormsSwitchable,
 		GenderRate:           doc.GenderRate,
 		Genera:               doc.Genera,
@@ -133,3 +169,22 @@ func (r *MongoPokemonSpeciesRepository) toDetail(doc model.PokemonSpeciesDocumen
 		Varieties:            doc.Varieties,
 	}
 }
+
+// normalizeFlavorTexts removes the in-game line and page breaks from every entry.
+func normalizeFlavorTexts(entries []model.FlavorTextEntry) []model.FlavorTextEntry {
+	normalized := make([]model.FlavorTextEntry, len(entries))
+	for i, entry := range entries {
+		entry.FlavorText = utils.NormalizeFlavorText(entry.FlavorText)
+		normalized[i] = entry
+	}
+	return normalized
+}
+
+func normalizeFormDescriptions(descriptions []model.FormDescription) []model.FormDescription {
+	normalized := make([]model.FormDescription, len(descriptions))
+	for i, description := range descriptions {
+		description.Description = utils.NormalizeFlavorText(description.Description)
+		normalized[i] = description
+	}
+	return normalized
+}
@@ -3,19 +3,20 @@ package service
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
-	"sync"
-	"time"
+	"strings"
 
 	"pokedex/internal/pokemon-species/model"
 	"pokedex/internal/pokemon-species/repository"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
 )
 
 type PokemonSpeciesService interface {
-	SyncAllPokemonSpecies(ctx context.Context) error
+	SyncAllPokemonSpecies(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncPokemonSpecies(ctx context.Context, url string) error
 	GetPokemonSpecies(ctx context.Context, identifier string) (model.PokemonSpeciesDetail, error)
+	GetDexEntries(ctx context.Context, identifier string, query model.DexEntriesQuery) (model.DexEntriesResponse, error)
 }
 
 type pokemonSpeciesServiceImpl struct {
@@ -32,91 +33,93 @@ func NewPokemonSpeciesService(repo repository.PokemonSpeciesRepository, api *pok
 
 // SyncAllPokemonSpecies fetches all pokemon list and their details from PokeAPI
 // and stores them in the local repository. This should be run as a background job.
-func (s *pokemonSpeciesServiceImpl) SyncAllPokemonSpecies(ctx context.Context) error {
-	log.Println("Starting full data synchronization...")
-
-	limit := 100 // Fetch 100 pokemons at a time from PokeAPI
-	offset := 0
-	totalSynced := 0
+func (s *pokemonSpeciesServiceImpl) SyncAllPokemonSpecies(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
 
-	for {
-		listCtx, cancelList := context.WithTimeout(ctx, 30*time.Second)
-		listResponse, err := s.pokeAPIClient.FetchPokemonSpeciesList(listCtx, limit, offset)
-		cancelList()
+// SyncPokemonSpecies fetches a single pokemon species by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *pokemonSpeciesServiceImpl) SyncPokemonSpecies(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
 
-		if err != nil {
-			if err.Error() == "rate_limit_hit" { // Check for the string error, can be improved with custom error types
-				log.Println("Rate limit hit during list fetch, retrying after a delay...")
-				time.Sleep(5 * time.Second)
-				continue
-			}
-			return fmt.Errorf("failed to fetch pokemon list from PokeAPI: %w", err)
-		}
+// syncResource describes how pokemon species are listed, fetched and stored by the sync engine.
+func (s *pokemonSpeciesServiceImpl) syncResource() syncer.Resource[model.PokemonSpeciesDetail] {
+	return syncer.Resource[model.PokemonSpeciesDetail]{
+		Name:         "pokemon-species",
+		Endpoint:     "pokemon-species",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchPokemonSpeciesDetail,
+		Save:         s.pokemonSpeciesRepo.SavePokemonSpecies,
+		LastSyncedAt: s.pokemonSpeciesRepo.GetLastSyncedAt,
+	}
+}
 
-		if len(listResponse.Results) == 0 {
-			break // No more pokemons to fetch
-		}
+func (s *pokemonSpeciesServiceImpl) GetPokemonSpecies(ctx context.Context, identifier string) (model.PokemonSpeciesDetail, error) {
+	id, err := strconv.Atoi(identifier)
+	if err == nil {
+		return s.pokemonSpeciesRepo.GetPokemonSpeciesByID(ctx, id)
+	} else {
+		return s.pokemonSpeciesRepo.GetPokemonSpeciesByName(ctx, identifier)
+	}
+}
 
-		var wg sync.WaitGroup
-		resultsChan := make(chan struct {
-			Detail model.PokemonSpeciesDetail
-			Err    error
-		}, len(listResponse.Results))
+// GetDexEntries returns the pokedex entries of a species in one language (English
+// by default), optionally limited to some game versions, with its genus in that language.
+func (s *pokemonSpeciesServiceImpl) GetDexEntries(ctx context.Context, identifier string, query model.DexEntriesQuery) (model.DexEntriesResponse, error) {
+	language := query.Language
+	if language == "" {
+		language = "en"
+	}
 
-		// Enqueue each detail fetch through the shared client
-		for _, item := range listResponse.Results {
-			wg.Add(1)
-			go func(item model.PokemonSpeciesListItem) {
-				defer wg.Done()
-				detail, err := s.pokeAPIClient.FetchPokemonSpeciesDetail(ctx, item.URL)
-				resultsChan <- struct {
-					Detail model.PokemonSpeciesDetail
-					Err    error
-				}{Detail: detail, Err: err}
-			}(item)
+	wanted := make(map[string]bool, len(query.Versions))
+	if len(query.Versions) > 0 {
+		versionNames, err := s.pokemonSpeciesRepo.GetVersionNames(ctx)
+		if err != nil {
+			return model.DexEntriesResponse{}, err
 		}
-
-		// Wait for all detail fetches for the current batch to complete
-		go func() {
-			wg.Wait()
-			close(resultsChan)
-		}()
-
-		for res := range resultsChan {
-			if res.Err != nil {
-				log.Printf("Error fetching detail for a data: %v\n", res.Err)
-				if res.Err.Error() == "rate_limit_hit" {
-					log.Println("Rate limit hit during detail fetch, consider re-queuing or pausing sync.")
-				}
-				continue
-			}
-
-			// Save to Repository
-			err := s.pokemonSpeciesRepo.SavePokemonSpecies(ctx, res.Detail)
-			if err != nil {
-				log.Printf("Failed to save data %s (ID: %d) to repository: %v\n", res.Detail.Name, res.Detail.PokeAPIID, err)
-			} else {
-				totalSynced++
+		for _, version := range query.Versions {
+			// Version belum di-sync, terima semua nama
+			if len(versionNames) > 0 && !versionNames[version] {
+				return model.DexEntriesResponse{}, fmt.Errorf("version not found: %s", version)
 			}
+			wanted[version] = true
 		}
+	}
 
-		log.Printf("Batch processed. Total synced so far: %d\n", totalSynced)
+	species, err := s.GetPokemonSpecies(ctx, identifier)
+	if err != nil {
+		return model.DexEntriesResponse{}, err
+	}
 
-		offset += limit
-		if offset >= listResponse.Count {
+	res := model.DexEntriesResponse{
+		ID:       species.PokeAPIID,
+		Name:     species.Name,
+		Language: language,
+		Entries:  make([]model.DexEntry, 0),
+	}
+	// Kode bahasa PokeAPI memakai huruf besar, mis. "ja-Hrkt" dan "zh-Hans"
+	for _, genus := range species.Genera {
+		if strings.EqualFold(genus.Language.Name, language) {
+			res.Genus = genus.Genus
+			res.Language = genus.Language.Name
 			break
 		}
 	}
-
-	log.Printf("Full Pokémon data synchronization completed. Total unique data synced: %d\n", totalSynced)
-	return nil
-}
-
-func (s *pokemonSpeciesServiceImpl) GetPokemonSpecies(ctx context.Context, identifier string) (model.PokemonSpeciesDetail, error) {
-	id, err := strconv.Atoi(identifier)
-	if err == nil {
-		return s.pokemonSpeciesRepo.GetPokemonSpeciesByID(ctx, id)
-	} else {
-		return s.pokemonSpeciesRepo.GetPokemonSpeciesByName(ctx, identifier)
+	for _, entry := range species.FlavorTextEntries {
+		if !strings.EqualFold(entry.Language.Name, language) {
+			continue
+		}
+		if len(wanted) > 0 && !wanted[entry.Version.Name] {
+			continue
+		}
+		res.Language = entry.Language.Name
+		res.Entries = append(res.Entries, model.DexEntry{
+			Version:    entry.Version.Name,
+			FlavorText: entry.FlavorText,
+		})
 	}
+	res.Count = len(res.Entries)
+
+	return res, nil
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"pokedex/database"
 	"pokedex/internal/pokemon-type/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -22,6 +24,9 @@ type PokemonTypeRepository interface {
 	GetPokemonTypeList(ctx context.Context, limit, offset int, baseUrl string) ([]model.PokemonTypeListItem, int64, error)
 	GetWeaknessPokemonTypes(ctx context.Context, pokemonID int, pokemonTypes []string) ([]model.PokemonWeaknessTypes, error)
 	GetPokemonByID(ctx context.Context, pokemonID int) (model.PokemonInfo, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
 }
 
 type MongoPokemonTypeRepository struct {
@@ -46,7 +51,7 @@ func (r *MongoPokemonTypeRepository) SavePokemonType(ctx context.Context, pokemo
 	}
 
 	filter := bson.M{"id": doc.TypeID}
-	update := bson.M{"$set": doc}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
 	opts := options.Update().SetUpsert(true)
 
 	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
@@ -58,7 +63,7 @@ func (r *MongoPokemonTypeRepository) SavePokemonType(ctx context.Context, pokemo
 
 func (r *MongoPokemonTypeRepository) GetPokemonTypeByID(ctx context.Context, id int) (model.PokemonTypeDetailResponse, error) {
 	var doc model.PokemonTypeDocument
-	filter := bson.M{"id": id}
+	filter := tombstone.Active(bson.M{"id": id})
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -72,7 +77,7 @@ func (r *MongoPokemonTypeRepository) GetPokemonTypeByID(ctx context.Context, id
 
 func (r *MongoPokemonTypeRepository) GetPokemonTypeByName(ctx context.Context, name string) (model.PokemonTypeDetailResponse, error) {
 	var doc model.PokemonTypeDocument
-	filter := bson.M{"name": name}
+	filter := tombstone.Active(bson.M{"name": name})
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -85,7 +90,8 @@ func (r *MongoPokemonTypeRepository) GetPokemonTypeByName(ctx context.Context, n
 }
 
 func (r *MongoPokemonTypeRepository) GetPokemonTypeList(ctx context.Context, limit, offset int, baseUrl string) ([]model.PokemonTypeListItem, int64, error) {
-	totalCount, err := r.collection.CountDocuments(ctx, bson.M{})
+	filter := tombstone.Active(bson.M{})
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count types in DB: %w", err)
 	}
@@ -95,7 +101,7 @@ func (r *MongoPokemonTypeRepository) GetPokemonTypeList(ctx context.Context, lim
 	findOptions.SetSkip(int64(offset))
 	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual Pokemon ID
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve types list from DB: %w", err)
 	}
@@ -118,7 +124,7 @@ func (r *MongoPokemonTypeRepository) GetWeaknessPokemonTypes(ctx context.Context
 	findOptions := options.Find()
 	findOptions.SetSort(bson.D{{Key: "id", Value: 1}})
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
+	cursor, err := r.collection.Find(ctx, tombstone.Active(bson.M{}), findOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve types list from DB: %w", err)
 	}
@@ -150,7 +156,7 @@ func (r *MongoPokemonTypeRepository) GetWeaknessPokemonTypes(ctx context.Context
 func (r *MongoPokemonTypeRepository) GetPokemonByID(ctx context.Context, pokemonID int) (model.PokemonInfo, error) {
 	var doc model.PokemonInfo
 
-	filter := bson.M{"id": pokemonID}
+	filter := tombstone.Active(bson.M{"id": pokemonID})
 
 	err := r.pokemonCollection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
@@ -160,6 +166,21 @@ func (r *MongoPokemonTypeRepository) GetPokemonByID(ctx context.Context, pokemon
 	return doc, nil
 }
 
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored type whose ID is in ids.
+func (r *MongoPokemonTypeRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every type that is not tombstoned.
+func (r *MongoPokemonTypeRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every type whose ID was not seen by a full sync.
+func (r *MongoPokemonTypeRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
 func (r *MongoPokemonTypeRepository) toWeaknessTypes(doc model.PokemonTypeListItemDocument) model.PokemonWeaknessTypes {
 
 	res := model.PokemonWeaknessTypes{
@@ -3,17 +3,16 @@ package service
 import (
 	"context"
 	"fmt"
-	"log"
 	"pokedex/internal/pokemon-type/model"
 	"pokedex/internal/pokemon-type/repository"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
 	"strconv"
-	"sync"
-	"time"
 )
 
 type PokemonTypeService interface {
-	SyncAllPokemonType(ctx context.Context) error
+	SyncAllPokemonType(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncPokemonType(ctx context.Context, url string) error
 	GetPokemonType(ctx context.Context, identifier string) (model.PokemonTypeDetailResponse, error)
 	GetPokemonTypeList(ctx context.Context, limit, offset int, baseUrl string) (model.PokemonListTypeResponse, error)
 	GetWeaknessPokemonTypes(ctx context.Context, pokemonID int, pokemonTypes []string) (model.PokemonWeaknessResponse, error)
@@ -31,84 +30,28 @@ func NewPokemonTypeService(repo repository.PokemonTypeRepository, api *pokeapi.C
 	}
 }
 
-func (s *pokemonTypeServiceImpl) SyncAllPokemonType(ctx context.Context) error {
-	log.Println("Starting full data synchronization...")
-
-	limit := 50 // Fetch 100 pokemons at a time from PokeAPI
-	offset := 0
-	totalSynced := 0
-
-	for {
-		listCtx, cancelList := context.WithTimeout(ctx, 30*time.Second)
-		listResponse, err := s.pokeAPIClient.FetchPokemonTypeList(listCtx, limit, offset)
-		cancelList()
-
-		if err != nil {
-			if err.Error() == "rate_limit_hit" { // Check for the string error, can be improved with custom error types
-				log.Println("Rate limit hit during list fetch, retrying after a delay...")
-				time.Sleep(5 * time.Second)
-				continue
-			}
-			return fmt.Errorf("failed to fetch data list from PokeAPI: %w", err)
-		}
-
-		if len(listResponse.Results) == 0 {
-			break // No more data to fetch
-		}
-
-		var wg sync.WaitGroup
-		resultsChan := make(chan struct {
-			Detail model.PokemonTypeDetailResponse
-			Err    error
-		}, len(listResponse.Results))
-
-		// Enqueue each detail fetch through the shared client
-		for _, item := range listResponse.Results {
-			wg.Add(1)
-			go func(item model.PokemonTypeListItem) {
-				defer wg.Done()
-				detail, err := s.pokeAPIClient.FetchPokemonTypeDetail(ctx, item.URL)
-				resultsChan <- struct {
-					Detail model.PokemonTypeDetailResponse
-					Err    error
-				}{Detail: detail, Err: err}
-			}(item)
-		}
-
-		// Wait for all detail fetches for the current batch to complete
-		go func() {
-			wg.Wait()
-			close(resultsChan)
-		}()
-
-		for res := range resultsChan {
-			if res.Err != nil {
-				log.Printf("Error fetching detail for a data: %v\n", res.Err)
-				if res.Err.Error() == "rate_limit_hit" {
-					log.Println("Rate limit hit during detail fetch, consider re-queuing or pausing sync.")
-				}
-				continue
-			}
-
-			// Save to Repository
-			err := s.pokemonTypeRepo.SavePokemonType(ctx, res.Detail)
-			if err != nil {
-				log.Printf("Failed to save data (ID: %d) to repository: %v\n", res.Detail.TypeID, err)
-			} else {
-				totalSynced++
-			}
-		}
+func (s *pokemonTypeServiceImpl) SyncAllPokemonType(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
 
-		log.Printf("Batch processed. Total synced so far: %d\n", totalSynced)
+// SyncPokemonType fetches a single type by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *pokemonTypeServiceImpl) SyncPokemonType(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
 
-		offset += limit
-		if offset >= listResponse.Count {
-			break
-		}
+// syncResource describes how types are listed, fetched and stored by the sync engine.
+func (s *pokemonTypeServiceImpl) syncResource() syncer.Resource[model.PokemonTypeDetailResponse] {
+	return syncer.Resource[model.PokemonTypeDetailResponse]{
+		Name:         "pokemon-type",
+		Endpoint:     "type",
+		PageSize:     50,
+		FetchDetail:  s.pokeAPIClient.FetchPokemonTypeDetail,
+		Save:         s.pokemonTypeRepo.SavePokemonType,
+		LastSyncedAt: s.pokemonTypeRepo.GetLastSyncedAt,
+		ActiveNames:  s.pokemonTypeRepo.GetActiveNames,
+		Reconcile:    s.pokemonTypeRepo.ReconcileTombstones,
 	}
-
-	log.Printf("Full Type Pokémon data synchronization completed. Total unique data synced: %d\n", totalSynced)
-	return nil
 }
 
 func (s *pokemonTypeServiceImpl) GetPokemonType(ctx context.Context, identifier string) (model.PokemonTypeDetailResponse, error) {
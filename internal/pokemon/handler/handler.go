@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"pokedex/internal/pokemon/model"
 	"pokedex/internal/pokemon/service"
 
 	"github.com/gin-gonic/gin"
@@ -28,6 +30,12 @@ func (h *PokemonHandler) GetPokemonList(c *gin.Context) {
 	limitStr := c.DefaultQuery("limit", "10")
 	offsetStr := c.DefaultQuery("offset", "0")
 	searchQuery := c.DefaultQuery("q", "")
+	// Default true: varian non-default (mis. charizard-mega-x) ikut ditampilkan
+	includeForms, err := strconv.ParseBool(c.DefaultQuery("include_forms", "true"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid include_forms: %s", c.Query("include_forms"))})
+		return
+	}
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil || limit <= 0 {
@@ -49,7 +57,7 @@ func (h *PokemonHandler) GetPokemonList(c *gin.Context) {
 	}
 	baseUrl := fmt.Sprintf("%s://%s/api/v1/pokemon", scheme, c.Request.Host)
 
-	listResponse, err := h.pokemonService.GetPokemonList(ctx, limit, offset, baseUrl, searchQuery)
+	listResponse, err := h.pokemonService.GetPokemonList(ctx, limit, offset, baseUrl, searchQuery, includeForms)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokemon list"})
 		return
@@ -64,8 +72,23 @@ func (h *PokemonHandler) GetPokemonDetail(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
-	pokemon, err := h.pokemonService.GetPokemon(ctx, identifier)
+	// Optional, e.g. ?nature=adamant&version_group=red-blue,x-y (or version_group=all)
+	opts := model.PokemonDetailOptions{
+		Nature: strings.ToLower(c.Query("nature")),
+	}
+	for _, name := range strings.Split(c.Query("version_group"), ",") {
+		if name = strings.TrimSpace(strings.ToLower(name)); name != "" {
+			opts.VersionGroups = append(opts.VersionGroups, name)
+		}
+	}
+
+	pokemon, err := h.pokemonService.GetPokemon(ctx, identifier, opts)
 	if err != nil {
+		// Query parameter yang tidak dikenal adalah kesalahan dari client
+		if strings.HasPrefix(err.Error(), "nature not found: ") || strings.HasPrefix(err.Error(), "version group not found: ") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		// More robust error checking for "not found"
 		if err.Error() == fmt.Sprintf("pokemon not found: %s", identifier) {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -77,3 +100,65 @@ func (h *PokemonHandler) GetPokemonDetail(c *gin.Context) {
 
 	c.JSON(http.StatusOK, pokemon)
 }
+
+// GetPokemonSprites handles GET /api/v1/pokemon/:identifier/sprites?variant=shiny&generation=v
+func (h *PokemonHandler) GetPokemonSprites(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	query := model.PokemonSpritesQuery{
+		Variant:    c.Query("variant"),
+		Generation: c.Query("generation"),
+	}
+
+	sprites, err := h.pokemonService.GetPokemonSprites(ctx, identifier, query)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid sprite query: ") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "pokemon not found: ") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokemon sprites"})
+		return
+	}
+
+	c.JSON(http.StatusOK, sprites)
+}
+
+// GetPokemonCry handles GET /api/v1/pokemon/:identifier/cry?version=legacy
+func (h *PokemonHandler) GetPokemonCry(c *gin.Context) {
+	identifier := c.Param("identifier")
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	cry, err := h.pokemonService.GetPokemonCry(ctx, identifier, c.Query("version"))
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid cry query: ") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if strings.HasPrefix(err.Error(), "pokemon not found: ") || strings.HasPrefix(err.Error(), "pokemon cry not found: ") {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pokemon cry"})
+		return
+	}
+
+	if cry.LocalPath == "" {
+		// Belum di-mirror: arahkan player ke file upstream (GitHub juga mendukung Range)
+		c.Redirect(http.StatusFound, cry.URL)
+		return
+	}
+
+	// http.ServeFile menangani Range, If-Range dan If-Modified-Since
+	c.Header("Content-Type", "audio/ogg")
+	c.Header("Cache-Control", "public, max-age=604800")
+	c.File(cry.LocalPath)
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"fmt"
+
+	"pokedex/internal/shared/spritemirror"
+)
+
+// Cry versions accepted by the cry endpoint.
+const (
+	CryVersionLatest = "latest" // Cry dari game terbaru
+	CryVersionLegacy = "legacy" // Cry asli generasi lama, null untuk pokemon yang lebih baru
+)
+
+// Cries are the OGG cry URLs of a pokemon; a missing cry is null.
+type Cries struct {
+	Latest *string `json:"latest" bson:"latest"`
+	Legacy *string `json:"legacy" bson:"legacy"`
+}
+
+// Version returns the URL of a cry version and whether the version is known.
+func (c Cries) Version(version string) (*string, bool) {
+	switch version {
+	case CryVersionLatest:
+		return c.Latest, true
+	case CryVersionLegacy:
+		return c.Legacy, true
+	}
+	return nil, false
+}
+
+// Mirrored points every cry at /api/v1/pokemon/:id/cry when mirroring is enabled,
+// so the web player gets range requests from the local copy.
+func (c Cries) Mirrored(pokemonID int) Cries {
+	if !spritemirror.Enabled() {
+		return c
+	}
+	local := func(url *string, version string) *string {
+		if url == nil {
+			return nil
+		}
+		endpoint := fmt.Sprintf("/api/v1/pokemon/%d/cry", pokemonID)
+		if version != CryVersionLatest {
+			endpoint += "?version=" + version
+		}
+		return &endpoint
+	}
+	return Cries{
+		Latest: local(c.Latest, CryVersionLatest),
+		Legacy: local(c.Legacy, CryVersionLegacy),
+	}
+}
+
+// PokemonCry is one cry of a pokemon as resolved for the cry endpoint.
+type PokemonCry struct {
+	URL       string // Upstream OGG URL
+	LocalPath string // File in the mirror, empty when the cry is not mirrored
+}
@@ -24,11 +24,6 @@ type PokemonListResponse struct {
 	Results  []PokemonListItem `json:"results" bson:"results"`
 }
 
-type Sprites struct {
-	FrontDefault string                 `json:"front_default" bson:"front_default"`
-	Other        map[string]interface{} `json:"other,omitempty" bson:"other,omitempty"`
-}
-
 // ResourceReference represents a generic name and URL reference
 type ResourceReference struct {
 	Name string `json:"name" bson:"name"`
@@ -67,6 +62,18 @@ type PokemonMoves struct {
 	} `json:"version_group_details" bson:"version_group_details"`
 }
 
+// PokemonHeldItem is an item the pokemon may hold when encountered in the wild.
+type PokemonHeldItem struct {
+	Item           ResourceReference       `json:"item" bson:"item"`
+	VersionDetails []HeldItemVersionDetail `json:"version_details" bson:"version_details"`
+}
+
+// HeldItemVersionDetail is the chance (in percent) of holding the item in one version.
+type HeldItemVersionDetail struct {
+	Rarity  int               `json:"rarity" bson:"rarity"`
+	Version ResourceReference `json:"version" bson:"version"`
+}
+
 type PokemonTraining struct {
 	CaptureRate        int     `json:"capture_rate"`
 	CaptureRatePercent float64 `json:"capture_rate_percent"`
@@ -96,6 +103,9 @@ type GroupedMoveInfo struct {
 	LevelLearnedAt  int    `json:"level_learned_at"`
 	MoveLearnMethod string `json:"move_learn_method"`
 	Order           int    `json:"order"`
+	Type            string `json:"type"`         // Kosong jika move belum di-sync
+	Power           *int   `json:"power"`        // null untuk move status
+	DamageClass     string `json:"damage_class"` // physical, special atau status
 }
 
 type MovesByLearnMethod struct {
@@ -115,12 +125,13 @@ type PokemonDetail struct {
 	Weight                 int                  `json:"weight" bson:"weight"`
 	BaseExperience         int                  `json:"base_experience" bson:"base_experience"`
 	Sprites                Sprites              `json:"sprites" bson:"sprites"`
+	Cries                  Cries                `json:"cries" bson:"cries"`
 	Types                  []PokemonType        `json:"types" bson:"types"`
 	Stats                  []PokemonStat        `json:"stats" bson:"stats"`
 	Abilities              []PokemonAbility     `json:"abilities" bson:"abilities"`
 	Forms                  []ResourceReference  `json:"forms" bson:"forms"`
 	GameIndices            []PokemonGameIndices `json:"game_indices" bson:"game_indices"`
-	HeldItems              []interface{}        `json:"held_items" bson:"held_items"`
+	HeldItems              []PokemonHeldItem    `json:"held_items" bson:"held_items"`
 	IsDefault              bool                 `json:"is_default" bson:"is_default"`
 	LocationAreaEncounters string               `json:"location_area_encounters" bson:"location_area_encounters"`
 	Moves                  []PokemonMoves       `json:"moves" bson:"moves"`
@@ -145,13 +156,16 @@ type PokemonDetailResponse struct {
 	Weight         int                            `json:"weight"`
 	BaseExperience int                            `json:"base_experience"`
 	Sprites        Sprites                        `json:"sprites"`
+	Cries          Cries                          `json:"cries"`
 	Types          []PokemonType                  `json:"types"`
 	Stats          []PokemonStatFull              `json:"stats"`
 	Abilities      []PokemonAbility               `json:"abilities"`
+	HeldItems      []PokemonHeldItem              `json:"held_items"`
 	Evolution      evolution_model.EvolutionChain `json:"evolution"`
 	GroupedMoves   []GroupedVersionMoves          `json:"grouped_moves"`
 	Order          int                            `json:"order"`
 	Habitat        string                         `json:"habitat"`
+	Category       string                         `json:"category"` // Genus dalam bahasa Inggris, mis. "Seed Pokémon"
 	Thumbnail      string                         `json:"thumbnail"`
 	Training       PokemonTraining                `json:"training"`
 	Breeding       PokemonBreeding                `json:"breeding"`
@@ -163,6 +177,16 @@ type PokemonDetailResponse struct {
 	IsMythical     bool                           `json:"is_mythical"`
 	PokedexNumbers []PokemonNumber                `json:"pokedex_numbers"`
 	EvolutionID    int                            `json:"evolution_id"`
+	Nature         string                         `json:"nature,omitempty"` // Diisi jika stats dihitung untuk nature tertentu
+}
+
+// AllVersionGroups is the ?version_group value that returns the grouped moves of every version group.
+const AllVersionGroups = "all"
+
+// PokemonDetailOptions are the optional query parameters of the pokemon detail endpoint.
+type PokemonDetailOptions struct {
+	Nature        string   // ?nature=adamant, computes min/max stats for that nature only
+	VersionGroups []string // ?version_group=red-blue,x-y (or "all"), empty means the default version groups
 }
 
 // PokemonDocument is the structure to store in MongoDB
@@ -174,12 +198,13 @@ type PokemonDocument struct {
 	Weight                 int                  `bson:"weight"`
 	BaseExperience         int                  `bson:"base_experience"`
 	Sprites                Sprites              `bson:"sprites"`
+	Cries                  Cries                `bson:"cries"`
 	Types                  []PokemonType        `bson:"types"`
 	Stats                  []PokemonStat        `bson:"stats"`
 	Abilities              []PokemonAbility     `bson:"abilities"`
 	Forms                  []ResourceReference  `bson:"forms"`
 	GameIndices            []PokemonGameIndices `bson:"game_indices"`
-	HeldItems              []interface{}        `bson:"held_items"`
+	HeldItems              []PokemonHeldItem    `bson:"held_items"`
 	IsDefault              bool                 `bson:"is_default"`
 	LocationAreaEncounters string               `bson:"location_area_encounters"`
 	Moves                  []PokemonMoves       `bson:"moves"`
@@ -0,0 +1,178 @@
+package model
+
+import (
+	"pokedex/internal/shared/spritemirror"
+	"pokedex/utils"
+)
+
+// SpriteSet holds the front and back sprite URLs of one sprite source. PokeAPI
+// returns null for every sprite that does not exist, so each URL is a pointer.
+type SpriteSet struct {
+	FrontDefault     *string `json:"front_default" bson:"front_default"`
+	FrontFemale      *string `json:"front_female" bson:"front_female"`
+	FrontShiny       *string `json:"front_shiny" bson:"front_shiny"`
+	FrontShinyFemale *string `json:"front_shiny_female" bson:"front_shiny_female"`
+	BackDefault      *string `json:"back_default" bson:"back_default"`
+	BackFemale       *string `json:"back_female" bson:"back_female"`
+	BackShiny        *string `json:"back_shiny" bson:"back_shiny"`
+	BackShinyFemale  *string `json:"back_shiny_female" bson:"back_shiny_female"`
+}
+
+// OtherSprites are the sprite sources that do not belong to a single game.
+type OtherSprites struct {
+	DreamWorld      SpriteSet `json:"dream_world" bson:"dream_world"`
+	Home            SpriteSet `json:"home" bson:"home"`
+	OfficialArtwork SpriteSet `json:"official-artwork" bson:"official-artwork"`
+	Showdown        SpriteSet `json:"showdown" bson:"showdown"` // Animated GIF
+}
+
+// VersionSprites are the sprites of one game (e.g. "black-white"). Older games
+// also have gray and transparent variants, Black/White adds animated sprites.
+type VersionSprites struct {
+	SpriteSet             `bson:",inline"`
+	FrontGray             *string    `json:"front_gray,omitempty" bson:"front_gray,omitempty"`
+	BackGray              *string    `json:"back_gray,omitempty" bson:"back_gray,omitempty"`
+	FrontTransparent      *string    `json:"front_transparent,omitempty" bson:"front_transparent,omitempty"`
+	BackTransparent       *string    `json:"back_transparent,omitempty" bson:"back_transparent,omitempty"`
+	FrontShinyTransparent *string    `json:"front_shiny_transparent,omitempty" bson:"front_shiny_transparent,omitempty"`
+	BackShinyTransparent  *string    `json:"back_shiny_transparent,omitempty" bson:"back_shiny_transparent,omitempty"`
+	Animated              *SpriteSet `json:"animated,omitempty" bson:"animated,omitempty"`
+}
+
+// Sprites is the full sprite catalogue of a pokemon as returned by PokeAPI.
+// Versions is keyed by generation (e.g. "generation-v") and then by game.
+type Sprites struct {
+	SpriteSet `bson:",inline"`
+	Other     OtherSprites                         `json:"other" bson:"other"`
+	Versions  map[string]map[string]VersionSprites `json:"versions,omitempty" bson:"versions,omitempty"`
+}
+
+// Sprite variants accepted by SpriteSet.Variant.
+const (
+	SpriteVariantDefault     = "default"
+	SpriteVariantShiny       = "shiny"
+	SpriteVariantFemale      = "female"
+	SpriteVariantShinyFemale = "shiny-female"
+)
+
+// Variant returns the front and back sprite of a variant and whether the variant is known.
+func (s SpriteSet) Variant(variant string) (front, back *string, ok bool) {
+	switch variant {
+	case SpriteVariantDefault:
+		return s.FrontDefault, s.BackDefault, true
+	case SpriteVariantShiny:
+		return s.FrontShiny, s.BackShiny, true
+	case SpriteVariantFemale:
+		return s.FrontFemale, s.BackFemale, true
+	case SpriteVariantShinyFemale:
+		return s.FrontShinyFemale, s.BackShinyFemale, true
+	}
+	return nil, nil, false
+}
+
+// mapURLs returns a copy of s with f applied to every sprite URL.
+func (s SpriteSet) mapURLs(f func(*string) *string) SpriteSet {
+	return SpriteSet{
+		FrontDefault:     f(s.FrontDefault),
+		FrontFemale:      f(s.FrontFemale),
+		FrontShiny:       f(s.FrontShiny),
+		FrontShinyFemale: f(s.FrontShinyFemale),
+		BackDefault:      f(s.BackDefault),
+		BackFemale:       f(s.BackFemale),
+		BackShiny:        f(s.BackShiny),
+		BackShinyFemale:  f(s.BackShinyFemale),
+	}
+}
+
+// mapURLs returns a deep copy of s with f applied to every sprite URL.
+func (s Sprites) mapURLs(f func(*string) *string) Sprites {
+	res := Sprites{
+		SpriteSet: s.SpriteSet.mapURLs(f),
+		Other: OtherSprites{
+			DreamWorld:      s.Other.DreamWorld.mapURLs(f),
+			Home:            s.Other.Home.mapURLs(f),
+			OfficialArtwork: s.Other.OfficialArtwork.mapURLs(f),
+			Showdown:        s.Other.Showdown.mapURLs(f),
+		},
+	}
+	if s.Versions == nil {
+		return res
+	}
+
+	res.Versions = make(map[string]map[string]VersionSprites, len(s.Versions))
+	for generation, games := range s.Versions {
+		mapped := make(map[string]VersionSprites, len(games))
+		for name, game := range games {
+			v := VersionSprites{
+				SpriteSet:             game.SpriteSet.mapURLs(f),
+				FrontGray:             f(game.FrontGray),
+				BackGray:              f(game.BackGray),
+				FrontTransparent:      f(game.FrontTransparent),
+				BackTransparent:       f(game.BackTransparent),
+				FrontShinyTransparent: f(game.FrontShinyTransparent),
+				BackShinyTransparent:  f(game.BackShinyTransparent),
+			}
+			if game.Animated != nil {
+				animated := game.Animated.mapURLs(f)
+				v.Animated = &animated
+			}
+			mapped[name] = v
+		}
+		res.Versions[generation] = mapped
+	}
+	return res
+}
+
+// URLs returns every sprite URL in the catalogue.
+func (s Sprites) URLs() []string {
+	var urls []string
+	s.mapURLs(func(url *string) *string {
+		if url != nil {
+			urls = append(urls, *url)
+		}
+		return url
+	})
+	return urls
+}
+
+// Mirrored returns s with every sprite pointing at the local mirror when
+// mirroring is enabled (see cmd/sprite-sync).
+func (s Sprites) Mirrored() Sprites {
+	if !spritemirror.Enabled() {
+		return s
+	}
+	return s.mapURLs(spritemirror.Ptr)
+}
+
+// Thumbnail returns the official artwork of the pokemon. Pokemon whose sprites
+// were synced before the typed catalogue existed fall back to the PokeAPI path.
+func (s Sprites) Thumbnail(pokemonID int) string {
+	if s.Other.OfficialArtwork.FrontDefault != nil {
+		return spritemirror.URL(*s.Other.OfficialArtwork.FrontDefault)
+	}
+	return spritemirror.URL(utils.GetThumbnailPokemon(pokemonID))
+}
+
+// PokemonSpriteEntry is the front and back sprite of one source for the requested variant.
+type PokemonSpriteEntry struct {
+	Source     string  `json:"source"` // "default", "official-artwork", "home", ... or a game, e.g. "black-white"
+	Generation string  `json:"generation,omitempty"`
+	Animated   bool    `json:"animated"`
+	Front      *string `json:"front"`
+	Back       *string `json:"back"`
+}
+
+// PokemonSpritesResponse is the response of /api/v1/pokemon/:identifier/sprites.
+type PokemonSpritesResponse struct {
+	ID         int                  `json:"id"`
+	Name       string               `json:"name"`
+	Variant    string               `json:"variant"`
+	Generation string               `json:"generation,omitempty"`
+	Sprites    []PokemonSpriteEntry `json:"sprites"`
+}
+
+// PokemonSpritesQuery holds the optional ?variant= and ?generation= of the sprites endpoint.
+type PokemonSpritesQuery struct {
+	Variant    string
+	Generation string
+}
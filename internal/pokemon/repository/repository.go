@@ -7,8 +7,15 @@ import (
 	"time"
 
 	"pokedex/database"
+	generation_repo "pokedex/internal/generation/repository"
+	move_model "pokedex/internal/move/model"
+	move_repo "pokedex/internal/move/repository"
+	nature_model "pokedex/internal/nature/model"
+	nature_repo "pokedex/internal/nature/repository"
 	pokemon_species_model "pokedex/internal/pokemon-species/model"
 	pokemon_model "pokedex/internal/pokemon/model"
+	"pokedex/internal/shared/syncer"
+	"pokedex/internal/shared/tombstone"
 	"pokedex/utils"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -21,23 +28,36 @@ const pokemonCollectionName = "pokemons"
 // PokemonRepository defines the interface for persisting and retrieving Pokemon data.
 type PokemonRepository interface {
 	SavePokemon(ctx context.Context, pokemon pokemon_model.PokemonDetail) error
-	GetPokemonByID(ctx context.Context, id int) (pokemon_model.PokemonDetailResponse, error)
-	GetPokemonByName(ctx context.Context, name string) (pokemon_model.PokemonDetailResponse, error)
-	GetPokemonList(ctx context.Context, limit, offset int) ([]pokemon_model.PokemonDetail, int64, error)
-	SearchPokemons(ctx context.Context, query string, limit, offset int) ([]pokemon_model.PokemonDetail, int64, error)
+	GetPokemonByID(ctx context.Context, id int, versionGroups []string) (pokemon_model.PokemonDetailResponse, error)
+	GetPokemonByName(ctx context.Context, name string, versionGroups []string) (pokemon_model.PokemonDetailResponse, error)
+	GetPokemonMedia(ctx context.Context, id int, name string) (pokemon_model.PokemonDetail, error)
+	GetAllPokemonMedia(ctx context.Context) ([]pokemon_model.PokemonDetail, error)
+	GetPokemonList(ctx context.Context, limit, offset int, includeForms bool) ([]pokemon_model.PokemonDetail, int64, error)
+	SearchPokemons(ctx context.Context, query string, limit, offset int, includeForms bool) ([]pokemon_model.PokemonDetail, int64, error)
+	GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error)
+	GetActiveNames(ctx context.Context) (map[int]string, error)
+	ReconcileTombstones(ctx context.Context, seenIDs []int) error
+	GetNatureByName(ctx context.Context, name string) (nature_model.NatureDetail, error)
+	GetVersionGroupOrder(ctx context.Context) (map[string]int, error)
 }
 
 // MongoPokemonRepository implements the PokemonRepository interface for MongoDB.
 type MongoPokemonRepository struct {
-	collection        *mongo.Collection
-	collectionSpecies *mongo.Collection
+	collection              *mongo.Collection
+	collectionSpecies       *mongo.Collection
+	collectionMoves         *mongo.Collection
+	collectionNatures       *mongo.Collection
+	collectionVersionGroups *mongo.Collection
 }
 
 // NewMongoPokemonRepository creates a new MongoDB repository.
 func NewMongoPokemonRepository() *MongoPokemonRepository {
 	return &MongoPokemonRepository{
-		collection:        database.MongoDatabase.Collection(pokemonCollectionName),
-		collectionSpecies: database.MongoDatabase.Collection("pokemon-species"),
+		collection:              database.MongoDatabase.Collection(pokemonCollectionName),
+		collectionSpecies:       database.MongoDatabase.Collection("pokemon-species"),
+		collectionMoves:         database.MongoDatabase.Collection(move_repo.MoveCollectionName),
+		collectionNatures:       database.MongoDatabase.Collection(nature_repo.NatureCollectionName),
+		collectionVersionGroups: database.MongoDatabase.Collection(generation_repo.VersionGroupCollectionName),
 	}
 }
 
@@ -50,6 +70,7 @@ func (r *MongoPokemonRepository) SavePokemon(ctx context.Context, pokemon pokemo
 		Weight:                 pokemon.Weight,
 		BaseExperience:         pokemon.BaseExperience,
 		Sprites:                pokemon.Sprites,
+		Cries:                  pokemon.Cries,
 		Types:                  pokemon.Types,
 		Stats:                  pokemon.Stats,
 		Abilities:              pokemon.Abilities,
@@ -65,7 +86,7 @@ func (r *MongoPokemonRepository) SavePokemon(ctx context.Context, pokemon pokemo
 	}
 
 	filter := bson.M{"id": doc.PokemonID}
-	update := bson.M{"$set": doc}
+	update := bson.M{"$set": doc, "$unset": bson.M{tombstone.Field: ""}}
 	opts := options.Update().SetUpsert(true)
 
 	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
@@ -75,9 +96,11 @@ func (r *MongoPokemonRepository) SavePokemon(ctx context.Context, pokemon pokemo
 	return nil
 }
 
-func (r *MongoPokemonRepository) GetPokemonByID(ctx context.Context, id int) (pokemon_model.PokemonDetailResponse, error) {
+// GetPokemonByID retrieves a pokemon detail with its grouped moves limited to
+// versionGroups (see moveVersionGroups).
+func (r *MongoPokemonRepository) GetPokemonByID(ctx context.Context, id int, versionGroups []string) (pokemon_model.PokemonDetailResponse, error) {
 	var doc pokemon_model.PokemonDocument
-	filter := bson.M{"id": id}
+	filter := tombstone.Active(bson.M{"id": id})
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -98,12 +121,24 @@ func (r *MongoPokemonRepository) GetPokemonByID(ctx context.Context, id int) (po
 		return pokemon_model.PokemonDetailResponse{}, fmt.Errorf("failed to retrieve pokemon species by id from DB: %w", err)
 	}
 
-	return r.toDetailResponse(doc, docSpecies), nil
+	moveSummaries, err := r.getMoveSummaries(ctx, doc.Moves)
+	if err != nil {
+		return pokemon_model.PokemonDetailResponse{}, err
+	}
+
+	versionGroupOrder, err := r.GetVersionGroupOrder(ctx)
+	if err != nil {
+		return pokemon_model.PokemonDetailResponse{}, err
+	}
+
+	allowedVersions := moveVersionGroups(versionGroups, versionGroupOrder)
+	return r.toDetailResponse(doc, docSpecies, moveSummaries, allowedVersions, versionGroupOrder), nil
 }
 
-func (r *MongoPokemonRepository) GetPokemonByName(ctx context.Context, name string) (pokemon_model.PokemonDetailResponse, error) {
+// GetPokemonByName is GetPokemonByID by pokemon name.
+func (r *MongoPokemonRepository) GetPokemonByName(ctx context.Context, name string, versionGroups []string) (pokemon_model.PokemonDetailResponse, error) {
 	var doc pokemon_model.PokemonDocument
-	filter := bson.M{"name": name}
+	filter := tombstone.Active(bson.M{"name": name})
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -124,11 +159,65 @@ func (r *MongoPokemonRepository) GetPokemonByName(ctx context.Context, name stri
 		return pokemon_model.PokemonDetailResponse{}, fmt.Errorf("failed to retrieve pokemon species by name from DB: %w", err)
 	}
 
-	return r.toDetailResponse(doc, docSpecies), nil
+	moveSummaries, err := r.getMoveSummaries(ctx, doc.Moves)
+	if err != nil {
+		return pokemon_model.PokemonDetailResponse{}, err
+	}
+
+	versionGroupOrder, err := r.GetVersionGroupOrder(ctx)
+	if err != nil {
+		return pokemon_model.PokemonDetailResponse{}, err
+	}
+
+	allowedVersions := moveVersionGroups(versionGroups, versionGroupOrder)
+	return r.toDetailResponse(doc, docSpecies, moveSummaries, allowedVersions, versionGroupOrder), nil
 }
 
-func (r *MongoPokemonRepository) GetPokemonList(ctx context.Context, limit, offset int) ([]pokemon_model.PokemonDetail, int64, error) {
-	totalCount, err := r.collection.CountDocuments(ctx, bson.M{})
+// GetPokemonMedia looks up a pokemon by ID (when id > 0) or by name and returns
+// only its ID, name, sprites and cries.
+func (r *MongoPokemonRepository) GetPokemonMedia(ctx context.Context, id int, name string) (pokemon_model.PokemonDetail, error) {
+	filter := bson.M{"name": name}
+	if id > 0 {
+		filter = bson.M{"id": id}
+	}
+
+	var doc pokemon_model.PokemonDetail
+	findOptions := options.FindOne().SetProjection(bson.M{"id": 1, "name": 1, "sprites": 1, "cries": 1})
+	err := r.collection.FindOne(ctx, tombstone.Active(filter), findOptions).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			if id > 0 {
+				return pokemon_model.PokemonDetail{}, fmt.Errorf("pokemon not found: %d", id)
+			}
+			return pokemon_model.PokemonDetail{}, fmt.Errorf("pokemon not found: %s", name)
+		}
+		return pokemon_model.PokemonDetail{}, fmt.Errorf("failed to retrieve pokemon media from DB: %w", err)
+	}
+	return doc, nil
+}
+
+// GetAllPokemonMedia returns the ID, name, sprites and cries of every pokemon that is not tombstoned.
+func (r *MongoPokemonRepository) GetAllPokemonMedia(ctx context.Context) ([]pokemon_model.PokemonDetail, error) {
+	findOptions := options.Find().SetProjection(bson.M{"id": 1, "name": 1, "sprites": 1, "cries": 1})
+	cursor, err := r.collection.Find(ctx, tombstone.Active(bson.M{}), findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve pokemon media from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []pokemon_model.PokemonDetail
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode pokemon media from DB: %w", err)
+	}
+	return docs, nil
+}
+
+func (r *MongoPokemonRepository) GetPokemonList(ctx context.Context, limit, offset int, includeForms bool) ([]pokemon_model.PokemonDetail, int64, error) {
+	filter := tombstone.Active(bson.M{})
+	if !includeForms {
+		filter["is_default"] = true // Sembunyikan varian non-default (mega, regional, gmax, ...)
+	}
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count pokemons in DB: %w", err)
 	}
@@ -138,7 +227,7 @@ func (r *MongoPokemonRepository) GetPokemonList(ctx context.Context, limit, offs
 	findOptions.SetSkip(int64(offset))
 	findOptions.SetSort(bson.D{{Key: "id", Value: 1}}) // Sort by actual Pokemon ID
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve pokemon list from DB: %w", err)
 	}
@@ -157,13 +246,16 @@ func (r *MongoPokemonRepository) GetPokemonList(ctx context.Context, limit, offs
 	return pokemonDetails, totalCount, nil
 }
 
-func (r *MongoPokemonRepository) SearchPokemons(ctx context.Context, query string, limit, offset int) ([]pokemon_model.PokemonDetail, int64, error) {
+func (r *MongoPokemonRepository) SearchPokemons(ctx context.Context, query string, limit, offset int, includeForms bool) ([]pokemon_model.PokemonDetail, int64, error) {
 	// Buat filter regex untuk pencarian substring case-insensitive
-	filter := bson.M{
+	filter := tombstone.Active(bson.M{
 		"name": bson.M{
 			"$regex":   query,
 			"$options": "i", // "i" for case-insensitive
 		},
+	})
+	if !includeForms {
+		filter["is_default"] = true
 	}
 
 	totalCount, err := r.collection.CountDocuments(ctx, filter)
@@ -195,6 +287,41 @@ func (r *MongoPokemonRepository) SearchPokemons(ctx context.Context, query strin
 	return pokemonDetails, totalCount, nil
 }
 
+// GetLastSyncedAt returns the last_synced_at timestamp of every stored pokemon whose ID is in ids.
+func (r *MongoPokemonRepository) GetLastSyncedAt(ctx context.Context, ids []int) (map[int]int64, error) {
+	return syncer.LastSyncedAt(ctx, r.collection, ids)
+}
+
+// GetActiveNames returns the id -> name map of every pokemon that is not tombstoned.
+func (r *MongoPokemonRepository) GetActiveNames(ctx context.Context) (map[int]string, error) {
+	return tombstone.ActiveNames(ctx, r.collection)
+}
+
+// ReconcileTombstones hides every pokemon whose ID was not seen by a full sync.
+func (r *MongoPokemonRepository) ReconcileTombstones(ctx context.Context, seenIDs []int) error {
+	return tombstone.Reconcile(ctx, r.collection, seenIDs)
+}
+
+// GetNatureByName retrieves a nature from the natures collection, used for nature-aware stat ranges.
+func (r *MongoPokemonRepository) GetNatureByName(ctx context.Context, name string) (nature_model.NatureDetail, error) {
+	var nature nature_model.NatureDetail
+	filter := tombstone.Active(bson.M{"name": name})
+	err := r.collectionNatures.FindOne(ctx, filter).Decode(&nature)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nature_model.NatureDetail{}, fmt.Errorf("nature not found: %s", name)
+		}
+		return nature_model.NatureDetail{}, fmt.Errorf("failed to retrieve nature by name from DB: %w", err)
+	}
+	return nature, nil
+}
+
+// GetVersionGroupOrder returns the release order of every synced version group, keyed by name.
+// It is empty until the version-group sync has run.
+func (r *MongoPokemonRepository) GetVersionGroupOrder(ctx context.Context) (map[string]int, error) {
+	return generation_repo.VersionGroupOrder(ctx, r.collectionVersionGroups)
+}
+
 // toDetail converts a PokemonDocument to a pokemon_model.PokemonDetail.
 func (r *MongoPokemonRepository) toDetail(doc pokemon_model.PokemonDocument) pokemon_model.PokemonDetail {
 	return pokemon_model.PokemonDetail{
@@ -204,6 +331,7 @@ func (r *MongoPokemonRepository) toDetail(doc pokemon_model.PokemonDocument) pok
 		Weight:                 doc.Weight,
 		BaseExperience:         doc.BaseExperience,
 		Sprites:                doc.Sprites,
+		Cries:                  doc.Cries,
 		Types:                  doc.Types,
 		Stats:                  doc.Stats,
 		Abilities:              doc.Abilities,
@@ -218,11 +346,57 @@ func (r *MongoPokemonRepository) toDetail(doc pokemon_model.PokemonDocument) pok
 	}
 }
 
+// getMoveSummaries looks up the type, power and damage class of every move the pokemon learns.
+// Moves that are not synced yet are simply absent from the result.
+func (r *MongoPokemonRepository) getMoveSummaries(ctx context.Context, moves []pokemon_model.PokemonMoves) (map[string]move_model.MoveSummary, error) {
+	names := make([]string, 0, len(moves))
+	for _, m := range moves {
+		names = append(names, m.Move.Name)
+	}
+
+	findOptions := options.Find().SetProjection(bson.D{
+		{Key: "name", Value: 1},
+		{Key: "type.name", Value: 1},
+		{Key: "power", Value: 1},
+		{Key: "damage_class.name", Value: 1},
+	})
+
+	cursor, err := r.collectionMoves.Find(ctx, tombstone.Active(bson.M{"name": bson.M{"$in": names}}), findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve moves from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []move_model.MoveDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode moves from DB: %w", err)
+	}
+
+	summaries := make(map[string]move_model.MoveSummary, len(docs))
+	for _, doc := range docs {
+		summaries[doc.Name] = move_model.MoveSummary{
+			Name:        doc.Name,
+			Type:        doc.Type.Name,
+			Power:       doc.Power,
+			DamageClass: doc.DamageClass.Name,
+		}
+	}
+	return summaries, nil
+}
+
 func (r *MongoPokemonRepository) toDetailResponse(
 	doc pokemon_model.PokemonDocument,
-	docSpecies pokemon_species_model.PokemonSpeciesDocument) pokemon_model.PokemonDetailResponse {
+	docSpecies pokemon_species_model.PokemonSpeciesDocument,
+	moveSummaries map[string]move_model.MoveSummary,
+	allowedVersions []string,
+	versionGroupOrder map[string]int) pokemon_model.PokemonDetailResponse {
 
-	thumbnailImg := utils.GetThumbnailPokemon(doc.PokemonID)
+	thumbnailImg := doc.Sprites.Thumbnail(doc.PokemonID)
+
+	// Sprite per game cukup besar, tersedia lewat /pokemon/:identifier/sprites
+	sprites := doc.Sprites
+	sprites.Versions = nil
+	sprites = sprites.Mirrored()
 
 	eggGroups := make([]pokemon_model.ResourceReference, len(docSpecies.EggGroups))
 	for i, eg := range docSpecies.EggGroups {
@@ -251,7 +425,8 @@ func (r *MongoPokemonRepository) toDetailResponse(
 		}
 	}
 
-	allowedMoveVersion := []string{"black-white", "red-blue"}
+	category := utils.EnglishText(docSpecies.Genera)
+
 	allowedMoveMethod := []string{"egg", "level-up", "machine", "tutor"}
 
 	pokedexNumbers := []pokemon_model.PokemonNumber{}
@@ -275,11 +450,14 @@ func (r *MongoPokemonRepository) toDetailResponse(
 		Thumbnail:    thumbnailImg,
 		Order:        doc.Order,
 		Habitat:      docSpecies.Habitat.Name,
+		Category:     category,
 		Abilities:    doc.Abilities,
+		HeldItems:    doc.HeldItems,
 		Types:        doc.Types,
 		Stats:        calcStats,
-		GroupedMoves: GroupMovesByVersion(doc.Moves, allowedMoveVersion, allowedMoveMethod),
-		Sprites:      doc.Sprites,
+		GroupedMoves: GroupMovesByVersion(doc.Moves, allowedVersions, versionGroupOrder, allowedMoveMethod, moveSummaries),
+		Sprites:      sprites,
+		Cries:        doc.Cries.Mirrored(doc.PokemonID),
 		OtherNames:   otherNames,
 		Training: pokemon_model.PokemonTraining{
 			CaptureRate:        docSpecies.CaptureRate,
@@ -304,16 +482,52 @@ func (r *MongoPokemonRepository) toDetailResponse(
 	}
 }
 
-func isVersionAllowed(versionName string, allowedVersions []string) bool {
-	if len(allowedVersions) == 0 { // Jika daftar filter kosong, izinkan semua versi
-		return true
+// defaultMoveVersionGroups are the version groups pokemon detail returns moves for
+// unless ?version_group asks for others.
+var defaultMoveVersionGroups = []string{"red-blue", "black-white"}
+
+// moveVersionGroups resolves the ?version_group selection: nil (every version
+// group) for "all", the requested names, or the default version groups that
+// exist in versionGroupOrder. Before version groups are synced the defaults are used as is.
+func moveVersionGroups(requested []string, versionGroupOrder map[string]int) []string {
+	for _, name := range requested {
+		if name == pokemon_model.AllVersionGroups {
+			return nil
+		}
+	}
+	if len(requested) > 0 {
+		return requested
 	}
-	for _, allowed := range allowedVersions {
-		if versionName == allowed {
-			return true // Ditemukan di daftar yang diizinkan
+	if len(versionGroupOrder) == 0 {
+		return defaultMoveVersionGroups
+	}
+
+	var known []string
+	for _, name := range defaultMoveVersionGroups {
+		if _, ok := versionGroupOrder[name]; ok {
+			known = append(known, name)
 		}
 	}
-	return false // Tidak ditemukan di daftar yang diizinkan
+	if len(known) == 0 {
+		return defaultMoveVersionGroups
+	}
+	return known
+}
+
+func isVersionAllowed(versionName string, allowedVersions []string, versionGroupOrder map[string]int) bool {
+	if len(allowedVersions) > 0 {
+		for _, allowed := range allowedVersions {
+			if versionName == allowed {
+				return true
+			}
+		}
+		return false
+	}
+	if len(versionGroupOrder) == 0 { // Version group belum di-sync, izinkan semua versi
+		return true
+	}
+	_, ok := versionGroupOrder[versionName]
+	return ok // Hanya version group yang dikenal PokeAPI
 }
 
 func isMethodAllowed(methodName string, allowedMethods []string) bool {
@@ -328,7 +542,12 @@ func isMethodAllowed(methodName string, allowedMethods []string) bool {
 	return false
 }
 
-func GroupMovesByVersion(pokemonMoves []pokemon_model.PokemonMoves, allowedVersions []string, allowedMethods []string) []pokemon_model.GroupedVersionMoves {
+// GroupMovesByVersion groups the moves of a pokemon by version group and learn method.
+// Only allowedVersions are kept; when it is empty, every version group known to
+// versionGroupOrder is. Groups are ordered by versionGroupOrder (release order),
+// or by name before version groups are synced.
+// Each move is enriched with its type, power and damage class from moveSummaries, when present.
+func GroupMovesByVersion(pokemonMoves []pokemon_model.PokemonMoves, allowedVersions []string, versionGroupOrder map[string]int, allowedMethods []string, moveSummaries map[string]move_model.MoveSummary) []pokemon_model.GroupedVersionMoves {
 	// Langkah 1: Kumpulkan data ke dalam map sementara berdasarkan `versionGroupName`
 	// Ini menyimpan semua gerakan (belum dikelompokkan oleh metode) untuk setiap versi.
 	tempGroupedByVersionMap := make(map[string][]pokemon_model.GroupedMoveInfo)
@@ -336,11 +555,12 @@ func GroupMovesByVersion(pokemonMoves []pokemon_model.PokemonMoves, allowedVersi
 	for _, moveData := range pokemonMoves {
 		moveName := moveData.Move.Name
 		moveURL := moveData.Move.URL
+		summary := moveSummaries[moveName]
 
 		for _, detail := range moveData.VersionGroupDetails {
 			versionGroupName := detail.VersionGroup.Name
 
-			if !isVersionAllowed(versionGroupName, allowedVersions) {
+			if !isVersionAllowed(versionGroupName, allowedVersions, versionGroupOrder) {
 				continue
 			}
 
@@ -356,6 +576,9 @@ func GroupMovesByVersion(pokemonMoves []pokemon_model.PokemonMoves, allowedVersi
 				LevelLearnedAt:  detail.LevelLearnedAt,
 				MoveLearnMethod: methodName, // Gunakan methodName yang sudah difilter
 				Order:           detail.Order,
+				Type:            summary.Type,
+				Power:           summary.Power,
+				DamageClass:     summary.DamageClass,
 			}
 			tempGroupedByVersionMap[versionGroupName] = append(tempGroupedByVersionMap[versionGroupName], info)
 		}
@@ -399,8 +622,12 @@ func GroupMovesByVersion(pokemonMoves []pokemon_model.PokemonMoves, allowedVersi
 		})
 	}
 
-	// Opsional: Urutkan hasil akhir berdasarkan GroupName agar output konsisten
+	// Urutkan hasil akhir berdasarkan urutan rilis version group, atau GroupName jika belum di-sync
 	sort.Slice(finalResult, func(i, j int) bool {
+		oi, oj := versionGroupOrder[finalResult[i].GroupName], versionGroupOrder[finalResult[j].GroupName]
+		if oi != oj {
+			return oi < oj
+		}
 		return finalResult[i].GroupName < finalResult[j].GroupName
 	})
 
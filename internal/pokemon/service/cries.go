@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"pokedex/internal/pokemon/model"
+	"pokedex/internal/shared/spritemirror"
+)
+
+// GetPokemonCry resolves the latest (default) or legacy cry of a pokemon, and
+// the mirrored file when cmd/sprite-sync has downloaded it.
+func (s *pokemonServiceImpl) GetPokemonCry(ctx context.Context, identifier string, version string) (model.PokemonCry, error) {
+	version = strings.ToLower(strings.TrimSpace(version))
+	if version == "" {
+		version = model.CryVersionLatest
+	}
+	if _, ok := (model.Cries{}).Version(version); !ok {
+		return model.PokemonCry{}, fmt.Errorf("invalid cry query: unknown version %s", version)
+	}
+
+	id, _ := strconv.Atoi(identifier)
+	pokemon, err := s.pokemonRepo.GetPokemonMedia(ctx, id, strings.ToLower(identifier))
+	if err != nil {
+		return model.PokemonCry{}, err
+	}
+
+	url, _ := pokemon.Cries.Version(version)
+	if url == nil {
+		return model.PokemonCry{}, fmt.Errorf("pokemon cry not found: %s/%s", pokemon.Name, version)
+	}
+
+	cry := model.PokemonCry{URL: *url}
+	if local, ok := spritemirror.LocalPath(*url); ok {
+		cry.LocalPath = local
+	}
+	return cry, nil
+}
@@ -3,22 +3,24 @@ package service
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
-	"sync"
-	"time"
 
 	evolution_service "pokedex/internal/evolution/service"
 	"pokedex/internal/pokemon/model"
 	"pokedex/internal/pokemon/repository"
 	"pokedex/internal/shared/pokeapi"
+	"pokedex/internal/shared/syncer"
+	"pokedex/utils"
 )
 
 // PokemonService defines the business logic for Pokemon operations.
 type PokemonService interface {
-	SyncAllPokemons(ctx context.Context) error
-	GetPokemon(ctx context.Context, identifier string) (model.PokemonDetailResponse, error)
-	GetPokemonList(ctx context.Context, limit, offset int, baseUrl string, searchQuery string) (model.PokemonListResponse, error)
+	SyncAllPokemons(ctx context.Context, opts syncer.Options) (syncer.Stats, error)
+	SyncPokemon(ctx context.Context, url string) error
+	GetPokemon(ctx context.Context, identifier string, opts model.PokemonDetailOptions) (model.PokemonDetailResponse, error)
+	GetPokemonList(ctx context.Context, limit, offset int, baseUrl string, searchQuery string, includeForms bool) (model.PokemonListResponse, error)
+	GetPokemonSprites(ctx context.Context, identifier string, query model.PokemonSpritesQuery) (model.PokemonSpritesResponse, error)
+	GetPokemonCry(ctx context.Context, identifier string, version string) (model.PokemonCry, error)
 }
 
 // pokemonServiceImpl implements the PokemonService interface.
@@ -42,94 +44,49 @@ func NewPokemonService(
 }
 
 // SyncAllPokemons
-func (s *pokemonServiceImpl) SyncAllPokemons(ctx context.Context) error {
-	log.Println("Starting full Pokémon data synchronization...")
-
-	limit := 100 // Fetch 100 pokemons at a time from PokeAPI
-	offset := 0
-	totalSynced := 0
-
-	for {
-		listCtx, cancelList := context.WithTimeout(ctx, 30*time.Second)
-		listResponse, err := s.pokeAPIClient.FetchPokemonList(listCtx, limit, offset)
-		cancelList()
-
-		if err != nil {
-			if err.Error() == "rate_limit_hit" { // Check for the string error, can be improved with custom error types
-				log.Println("Rate limit hit during list fetch, retrying after a delay...")
-				time.Sleep(5 * time.Second)
-				continue
-			}
-			return fmt.Errorf("failed to fetch pokemon list from PokeAPI: %w", err)
-		}
-
-		if len(listResponse.Results) == 0 {
-			break // No more pokemons to fetch
-		}
-
-		var wg sync.WaitGroup
-		resultsChan := make(chan struct {
-			Detail model.PokemonDetail
-			Err    error
-		}, len(listResponse.Results))
-
-		// Enqueue each detail fetch through the shared client
-		for _, item := range listResponse.Results {
-			wg.Add(1)
-			go func(item model.PokemonListItem) {
-				defer wg.Done()
-				detail, err := s.pokeAPIClient.FetchPokemonDetail(ctx, item.URL)
-				resultsChan <- struct {
-					Detail model.PokemonDetail
-					Err    error
-				}{Detail: detail, Err: err}
-			}(item)
-		}
-
-		// Wait for all detail fetches for the current batch to complete
-		go func() {
-			wg.Wait()
-			close(resultsChan)
-		}()
-
-		for res := range resultsChan {
-			if res.Err != nil {
-				log.Printf("Error fetching detail for a pokemon: %v\n", res.Err)
-				if res.Err.Error() == "rate_limit_hit" {
-					log.Println("Rate limit hit during detail fetch, consider re-queuing or pausing sync.")
-				}
-				continue
-			}
-
-			// Save to Repository
-			err := s.pokemonRepo.SavePokemon(ctx, res.Detail)
-			if err != nil {
-				log.Printf("Failed to save Pokemon %s (ID: %d) to repository: %v\n", res.Detail.Name, res.Detail.ID, err)
-			} else {
-				totalSynced++
-			}
-		}
+func (s *pokemonServiceImpl) SyncAllPokemons(ctx context.Context, opts syncer.Options) (syncer.Stats, error) {
+	return syncer.Run(ctx, s.pokeAPIClient, opts, s.syncResource())
+}
 
-		log.Printf("Batch processed. Total synced so far: %d\n", totalSynced)
+// SyncPokemon fetches a single pokemon by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func (s *pokemonServiceImpl) SyncPokemon(ctx context.Context, url string) error {
+	return syncer.SyncOne(ctx, s.syncResource(), url)
+}
 
-		offset += limit
-		if offset >= listResponse.Count {
-			break
-		}
+// syncResource describes how pokemons are listed, fetched and stored by the sync engine.
+func (s *pokemonServiceImpl) syncResource() syncer.Resource[model.PokemonDetail] {
+	return syncer.Resource[model.PokemonDetail]{
+		Name:         "pokemon",
+		Endpoint:     "pokemon",
+		PageSize:     100,
+		FetchDetail:  s.pokeAPIClient.FetchPokemonDetail,
+		Save:         s.pokemonRepo.SavePokemon,
+		LastSyncedAt: s.pokemonRepo.GetLastSyncedAt,
+		ActiveNames:  s.pokemonRepo.GetActiveNames,
+		Reconcile:    s.pokemonRepo.ReconcileTombstones,
 	}
-
-	log.Printf("Full Pokémon data synchronization completed. Total unique pokemons synced: %d\n", totalSynced)
-	return nil
 }
 
-func (s *pokemonServiceImpl) GetPokemon(ctx context.Context, identifier string) (model.PokemonDetailResponse, error) {
+// GetPokemon retrieves a pokemon with its evolution chain. When opts.Nature is set,
+// the min/max stats are computed for that nature instead of the full range.
+// opts.VersionGroups picks the version groups of the grouped moves: the default
+// ones when empty, or every version group for "all".
+func (s *pokemonServiceImpl) GetPokemon(ctx context.Context, identifier string, opts model.PokemonDetailOptions) (model.PokemonDetailResponse, error) {
+	if err := s.validateVersionGroups(ctx, opts.VersionGroups); err != nil {
+		return model.PokemonDetailResponse{}, err
+	}
+
 	id, err := strconv.Atoi(identifier)
 
 	var pokemonDetail model.PokemonDetailResponse
 	if err == nil {
-		pokemonDetail, _ = s.pokemonRepo.GetPokemonByID(ctx, id)
+		pokemonDetail, err = s.pokemonRepo.GetPokemonByID(ctx, id, opts.VersionGroups)
 	} else {
-		pokemonDetail, _ = s.pokemonRepo.GetPokemonByName(ctx, identifier)
+		pokemonDetail, err = s.pokemonRepo.GetPokemonByName(ctx, identifier, opts.VersionGroups)
+	}
+	if err != nil {
+		return model.PokemonDetailResponse{}, err
 	}
 
 	evolutionPokemon, err := s.evolutionService.GetEvolution(ctx, strconv.Itoa(pokemonDetail.EvolutionID))
@@ -140,18 +97,72 @@ func (s *pokemonServiceImpl) GetPokemon(ctx context.Context, identifier string)
 
 	pokemonDetail.Evolution = evolutionPokemon
 
+	if opts.Nature != "" {
+		if err := s.applyNature(ctx, &pokemonDetail, opts.Nature); err != nil {
+			return model.PokemonDetailResponse{}, err
+		}
+	}
+
 	return pokemonDetail, err
 }
 
-func (s *pokemonServiceImpl) GetPokemonList(ctx context.Context, limit, offset int, baseUrl string, searchQuery string) (model.PokemonListResponse, error) {
+// validateVersionGroups checks every requested version group name against the
+// synced version groups. The default selection and "all" need no check.
+func (s *pokemonServiceImpl) validateVersionGroups(ctx context.Context, versionGroups []string) error {
+	if len(versionGroups) == 0 {
+		return nil
+	}
+	for _, name := range versionGroups {
+		if name == model.AllVersionGroups {
+			return nil
+		}
+	}
+
+	versionGroupOrder, err := s.pokemonRepo.GetVersionGroupOrder(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range versionGroups {
+		if _, ok := versionGroupOrder[name]; !ok {
+			return fmt.Errorf("version group not found: %s", name)
+		}
+	}
+	return nil
+}
+
+// applyNature recomputes MinStat and MaxStat of every stat for a single nature.
+func (s *pokemonServiceImpl) applyNature(ctx context.Context, pokemonDetail *model.PokemonDetailResponse, natureName string) error {
+	nature, err := s.pokemonRepo.GetNatureByName(ctx, natureName)
+	if err != nil {
+		return err
+	}
+
+	var increased, decreased string
+	if nature.IncreasedStat != nil {
+		increased = nature.IncreasedStat.Name
+	}
+	if nature.DecreasedStat != nil {
+		decreased = nature.DecreasedStat.Name
+	}
+
+	for i, stat := range pokemonDetail.Stats {
+		modifier := utils.NatureModifier(stat.StatName, increased, decreased)
+		pokemonDetail.Stats[i].MinStat = utils.CalcMinStatWithNature(stat.BaseStat, stat.StatName, modifier)
+		pokemonDetail.Stats[i].MaxStat = utils.CalcMaxStatWithNature(stat.BaseStat, stat.StatName, modifier)
+	}
+	pokemonDetail.Nature = nature.Name
+	return nil
+}
+
+func (s *pokemonServiceImpl) GetPokemonList(ctx context.Context, limit, offset int, baseUrl string, searchQuery string, includeForms bool) (model.PokemonListResponse, error) {
 	var pokemons []model.PokemonDetail
 	var totalCount int64
 	var err error
 
 	if searchQuery != "" {
-		pokemons, totalCount, err = s.pokemonRepo.SearchPokemons(ctx, searchQuery, limit, offset)
+		pokemons, totalCount, err = s.pokemonRepo.SearchPokemons(ctx, searchQuery, limit, offset, includeForms)
 	} else {
-		pokemons, totalCount, err = s.pokemonRepo.GetPokemonList(ctx, limit, offset)
+		pokemons, totalCount, err = s.pokemonRepo.GetPokemonList(ctx, limit, offset, includeForms)
 	}
 
 	if err != nil {
@@ -160,15 +171,12 @@ func (s *pokemonServiceImpl) GetPokemonList(ctx context.Context, limit, offset i
 
 	var listItems []model.PokemonListItem
 	for _, p := range pokemons {
-		defaultSpriteOfficial := "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/pokemon/other/official-artwork/"
-		thumbnailImg := defaultSpriteOfficial + fmt.Sprintf("%d.png", p.ID)
-
 		listItems = append(listItems, model.PokemonListItem{
 			ID:        p.ID,
 			Name:      p.Name,
 			URL:       fmt.Sprintf("%s/%d", baseUrl, p.ID),
 			Types:     p.Types,
-			Thumbnail: thumbnailImg,
+			Thumbnail: p.Sprites.Thumbnail(p.ID),
 		})
 	}
 
@@ -183,6 +191,9 @@ func (s *pokemonServiceImpl) GetPokemonList(ctx context.Context, limit, offset i
 		if searchQuery != "" {
 			url = fmt.Sprintf("%s&q=%s", url, searchQuery) // Tambahkan q parameter
 		}
+		if !includeForms {
+			url += "&include_forms=false"
+		}
 		nextURL = &url
 	}
 
@@ -196,6 +207,9 @@ func (s *pokemonServiceImpl) GetPokemonList(ctx context.Context, limit, offset i
 		if searchQuery != "" {
 			url = fmt.Sprintf("%s&q=%s", url, searchQuery) // Tambahkan q parameter
 		}
+		if !includeForms {
+			url += "&include_forms=false"
+		}
 		previousURL = &url
 	}
 	// --- AKHIR LOGIKA PEMBANGUNAN URL NEXT DAN PREVIOUS ---
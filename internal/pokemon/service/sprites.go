@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"pokedex/internal/pokemon/model"
+	"pokedex/internal/shared/spritemirror"
+)
+
+// spriteGenerations lists the generations of the PokeAPI sprite `versions` tree in release order.
+var spriteGenerations = []string{
+	"generation-i", "generation-ii", "generation-iii", "generation-iv", "generation-v",
+	"generation-vi", "generation-vii", "generation-viii", "generation-ix",
+}
+
+// GetPokemonSprites returns the sprites of one variant (default, shiny, female or
+// shiny-female). Without a generation it lists the main sprite, the other sources
+// and every game; with one (e.g. "v", "5" or "generation-v") only that generation's games.
+func (s *pokemonServiceImpl) GetPokemonSprites(ctx context.Context, identifier string, query model.PokemonSpritesQuery) (model.PokemonSpritesResponse, error) {
+	variant := strings.ToLower(strings.TrimSpace(query.Variant))
+	if variant == "" {
+		variant = model.SpriteVariantDefault
+	}
+	if _, _, ok := (model.SpriteSet{}).Variant(variant); !ok {
+		return model.PokemonSpritesResponse{}, fmt.Errorf("invalid sprite query: unknown variant %s", query.Variant)
+	}
+
+	id, _ := strconv.Atoi(identifier)
+	pokemon, err := s.pokemonRepo.GetPokemonMedia(ctx, id, strings.ToLower(identifier))
+	if err != nil {
+		return model.PokemonSpritesResponse{}, err
+	}
+
+	res := model.PokemonSpritesResponse{
+		ID:      pokemon.ID,
+		Name:    pokemon.Name,
+		Variant: variant,
+		Sprites: make([]model.PokemonSpriteEntry, 0),
+	}
+	add := func(source, generation string, animated bool, set model.SpriteSet) {
+		front, back, _ := set.Variant(variant)
+		if front == nil && back == nil {
+			return
+		}
+		res.Sprites = append(res.Sprites, model.PokemonSpriteEntry{
+			Source:     source,
+			Generation: generation,
+			Animated:   animated,
+			Front:      spritemirror.Ptr(front),
+			Back:       spritemirror.Ptr(back),
+		})
+	}
+
+	generations := sortedSpriteGenerations(pokemon.Sprites.Versions)
+	if query.Generation != "" {
+		generation := normalizeSpriteGeneration(query.Generation)
+		if _, ok := pokemon.Sprites.Versions[generation]; !ok && !isKnownSpriteGeneration(generation) {
+			return model.PokemonSpritesResponse{}, fmt.Errorf("invalid sprite query: unknown generation %s", query.Generation)
+		}
+		res.Generation = generation
+		generations = []string{generation}
+	} else {
+		add("default", "", false, pokemon.Sprites.SpriteSet)
+		add("official-artwork", "", false, pokemon.Sprites.Other.OfficialArtwork)
+		add("home", "", false, pokemon.Sprites.Other.Home)
+		add("dream-world", "", false, pokemon.Sprites.Other.DreamWorld)
+		add("showdown", "", true, pokemon.Sprites.Other.Showdown)
+	}
+
+	for _, generation := range generations {
+		games := pokemon.Sprites.Versions[generation]
+		names := make([]string, 0, len(games))
+		for name := range games {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			game := games[name]
+			add(name, generation, false, game.SpriteSet)
+			if game.Animated != nil {
+				add(name, generation, true, *game.Animated)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// normalizeSpriteGeneration turns "5", "v" or "Generation-V" into "generation-v".
+func normalizeSpriteGeneration(generation string) string {
+	generation = strings.ToLower(strings.TrimSpace(generation))
+	if n, err := strconv.Atoi(generation); err == nil && n >= 1 && n <= len(spriteGenerations) {
+		return spriteGenerations[n-1]
+	}
+	if !strings.HasPrefix(generation, "generation-") {
+		generation = "generation-" + generation
+	}
+	return generation
+}
+
+func isKnownSpriteGeneration(generation string) bool {
+	for _, g := range spriteGenerations {
+		if g == generation {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedSpriteGenerations returns the generations of a versions tree in release
+// order; generations newer than spriteGenerations come last, sorted by name.
+func sortedSpriteGenerations(versions map[string]map[string]model.VersionSprites) []string {
+	rank := make(map[string]int, len(spriteGenerations))
+	for i, g := range spriteGenerations {
+		rank[g] = i + 1
+	}
+
+	generations := make([]string, 0, len(versions))
+	for g := range versions {
+		generations = append(generations, g)
+	}
+	sort.Slice(generations, func(i, j int) bool {
+		ri, rj := rank[generations[i]], rank[generations[j]]
+		if ri == 0 || rj == 0 {
+			if ri != rj {
+				return ri != 0
+			}
+			return generations[i] < generations[j]
+		}
+		return ri < rj
+	})
+	return generations
+}
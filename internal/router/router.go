@@ -1,11 +1,26 @@
 package router
 
 import (
+	"os"
+	"path"
+	"path/filepath"
+
 	ability_handler "pokedex/internal/ability/handler"
+	berry_handler "pokedex/internal/berry/handler"
+	egg_group_handler "pokedex/internal/egg-group/handler"
+	encounter_handler "pokedex/internal/encounter/handler"
 	evolution_handler "pokedex/internal/evolution/handler"
+	generation_handler "pokedex/internal/generation/handler"
+	growth_rate_handler "pokedex/internal/growth-rate/handler"
+	item_handler "pokedex/internal/item/handler"
+	move_handler "pokedex/internal/move/handler"
+	nature_handler "pokedex/internal/nature/handler"
+	pokedex_handler "pokedex/internal/pokedex/handler"
+	pokemon_form_handler "pokedex/internal/pokemon-form/handler"
 	pokemon_species_handler "pokedex/internal/pokemon-species/handler"
 	pokemon_type_handler "pokedex/internal/pokemon-type/handler"
 	pokemon_handler "pokedex/internal/pokemon/handler"
+	"pokedex/internal/shared/spritemirror"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -19,6 +34,16 @@ func InitAPIRoutes(
 	pokemonSpeciesHandler *pokemon_species_handler.PokemonSpeciesHandler,
 	evolutionHandler *evolution_handler.EvolutionHandler,
 	pokemonTypeHandler *pokemon_type_handler.PokemonTypeHandler,
+	moveHandler *move_handler.MoveHandler,
+	itemHandler *item_handler.ItemHandler,
+	encounterHandler *encounter_handler.EncounterHandler,
+	natureHandler *nature_handler.NatureHandler,
+	generationHandler *generation_handler.GenerationHandler,
+	pokedexHandler *pokedex_handler.PokedexHandler,
+	eggGroupHandler *egg_group_handler.EggGroupHandler,
+	berryHandler *berry_handler.BerryHandler,
+	growthRateHandler *growth_rate_handler.GrowthRateHandler,
+	pokemonFormHandler *pokemon_form_handler.PokemonFormHandler,
 ) {
 
 	// Configure CORS options
@@ -35,12 +60,23 @@ func InitAPIRoutes(
 	// Apply CORS middleware
 	router.Use(cors.New(corsConfig))
 
+	// Sprite hasil cmd/sprite-sync, hanya kalau mirroring aktif
+	if spritemirror.Enabled() {
+		assets := router.Group(spritemirror.RoutePath)
+		assets.Use(spriteCacheHeaders())
+		assets.StaticFS("/", gin.Dir(spritemirror.Dir(), false))
+	}
+
 	v1 := router.Group("/api/v1")
 	{
 		pokemonGroup := v1.Group("/pokemon")
 		{
 			pokemonGroup.GET("", pokemonHandler.GetPokemonList)
 			pokemonGroup.GET("/:identifier", pokemonHandler.GetPokemonDetail)
+			pokemonGroup.GET("/:identifier/sprites", pokemonHandler.GetPokemonSprites)
+			pokemonGroup.GET("/:identifier/cry", pokemonHandler.GetPokemonCry)
+			pokemonGroup.GET("/:identifier/encounters", encounterHandler.GetPokemonEncounters)
+			pokemonGroup.GET("/:identifier/experience", growthRateHandler.CalculateExperience)
 		}
 		abilityGroup := v1.Group("/ability")
 		{
@@ -51,6 +87,8 @@ func InitAPIRoutes(
 		{
 			// pokemonSpeciesGroup.GET("/", pokemonHandler.GetPokemonList)
 			pokemonSpeciesGroup.GET("/:identifier", pokemonSpeciesHandler.GetPokemonSpeciesDetail)
+			pokemonSpeciesGroup.GET("/:identifier/varieties", pokemonFormHandler.GetSpeciesVarieties)
+			pokemonSpeciesGroup.GET("/:identifier/dex-entries", pokemonSpeciesHandler.GetDexEntries)
 		}
 		evolutionGroup := v1.Group("/evolution")
 		{
@@ -64,5 +102,74 @@ func InitAPIRoutes(
 			pokemonTypeGroup.GET("/:identifier", pokemonTypeHandler.GetPokemonTypeDetail)
 			pokemonTypeGroup.GET("/weakness/:pokemon-id", pokemonTypeHandler.GetWeaknessPokemonTypes)
 		}
+		moveGroup := v1.Group("/move")
+		{
+			moveGroup.GET("", moveHandler.GetMoveList)
+			moveGroup.GET("/:identifier", moveHandler.GetMoveDetail)
+		}
+		itemGroup := v1.Group("/item")
+		{
+			itemGroup.GET("", itemHandler.GetItemList)
+			itemGroup.GET("/:identifier", itemHandler.GetItemDetail)
+		}
+		natureGroup := v1.Group("/nature")
+		{
+			natureGroup.GET("", natureHandler.GetNatureList)
+			natureGroup.GET("/:identifier", natureHandler.GetNatureDetail)
+		}
+		generationGroup := v1.Group("/generation")
+		{
+			generationGroup.GET("", generationHandler.GetGenerationList)
+			generationGroup.GET("/:identifier", generationHandler.GetGenerationDetail)
+		}
+		versionGroupGroup := v1.Group("/version-group")
+		{
+			versionGroupGroup.GET("", generationHandler.GetVersionGroupList)
+			versionGroupGroup.GET("/:identifier", generationHandler.GetVersionGroupDetail)
+		}
+		pokedexGroup := v1.Group("/pokedex")
+		{
+			pokedexGroup.GET("", pokedexHandler.GetPokedexList)
+			pokedexGroup.GET("/:identifier", pokedexHandler.GetPokedexEntries)
+		}
+		eggGroupGroup := v1.Group("/egg-group")
+		{
+			eggGroupGroup.GET("", eggGroupHandler.GetEggGroupList)
+			eggGroupGroup.GET("/:identifier", eggGroupHandler.GetEggGroupDetail)
+		}
+		breedingGroup := v1.Group("/breeding")
+		{
+			breedingGroup.GET("/compatible", eggGroupHandler.CheckBreedingCompatibility)
+			breedingGroup.GET("/egg-move-chain", eggGroupHandler.FindEggMoveChains)
+		}
+		berryGroup := v1.Group("/berry")
+		{
+			berryGroup.GET("", berryHandler.GetBerryList)
+			berryGroup.GET("/:identifier", berryHandler.GetBerryDetail)
+		}
+		berryFlavorGroup := v1.Group("/berry-flavor")
+		{
+			berryFlavorGroup.GET("", berryHandler.GetBerryFlavorList)
+			berryFlavorGroup.GET("/:identifier", berryHandler.GetBerryFlavorDetail)
+		}
+		growthRateGroup := v1.Group("/growth-rate")
+		{
+			growthRateGroup.GET("", growthRateHandler.GetGrowthRateList)
+			growthRateGroup.GET("/:identifier", growthRateHandler.GetGrowthRateDetail)
+		}
+	}
+}
+
+// spriteCacheHeaders lets browsers and proxies keep mirrored sprites for a week.
+// http.FileServer also answers If-Modified-Since, so revalidation is cheap.
+// Missing sprites are not cached, so they appear once cmd/sprite-sync fetched them.
+func spriteCacheHeaders() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rel := path.Clean("/" + c.Param("filepath"))
+		info, err := os.Stat(filepath.Join(spritemirror.Dir(), filepath.FromSlash(rel)))
+		if err == nil && !info.IsDir() {
+			c.Header("Cache-Control", "public, max-age=604800")
+		}
+		c.Next()
 	}
 }
@@ -0,0 +1,94 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pokedex/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const checkpointCollectionName = "sync_checkpoints"
+
+// Checkpoint records how far a sync run got through a paginated PokeAPI resource.
+// Offset is the offset of the next batch to fetch, i.e. every batch before it
+// has been fully processed.
+type Checkpoint struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Resource  string             `bson:"resource"`
+	RunID     string             `bson:"run_id"`
+	Offset    int                `bson:"offset"`
+	Completed bool               `bson:"completed"`
+	Abandoned bool               `bson:"abandoned"`
+	StartedAt int64              `bson:"started_at"`
+	UpdatedAt int64              `bson:"updated_at"`
+}
+
+// CheckpointRepository defines the interface for persisting sync checkpoints.
+type CheckpointRepository interface {
+	SaveCheckpoint(ctx context.Context, cp Checkpoint) error
+	GetResumableCheckpoint(ctx context.Context, resource string) (Checkpoint, bool, error)
+	AbandonCheckpoints(ctx context.Context, resource string) error
+}
+
+// MongoCheckpointRepository implements the CheckpointRepository interface for MongoDB.
+type MongoCheckpointRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoCheckpointRepository creates a new MongoDB repository for sync checkpoints.
+func NewMongoCheckpointRepository() *MongoCheckpointRepository {
+	return &MongoCheckpointRepository{
+		collection: database.MongoDatabase.Collection(checkpointCollectionName),
+	}
+}
+
+// SaveCheckpoint upserts the checkpoint identified by its resource and run ID.
+func (r *MongoCheckpointRepository) SaveCheckpoint(ctx context.Context, cp Checkpoint) error {
+	cp.UpdatedAt = time.Now().Unix()
+
+	filter := bson.M{"resource": cp.Resource, "run_id": cp.RunID}
+	update := bson.M{"$set": cp}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save checkpoint for %s (run %s): %w", cp.Resource, cp.RunID, err)
+	}
+	return nil
+}
+
+// GetResumableCheckpoint returns the most recently updated run of a resource
+// that neither completed nor was abandoned. The bool is false when there is nothing to resume.
+func (r *MongoCheckpointRepository) GetResumableCheckpoint(ctx context.Context, resource string) (Checkpoint, bool, error) {
+	var cp Checkpoint
+	filter := bson.M{"resource": resource, "completed": false, "abandoned": false}
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+
+	err := r.collection.FindOne(ctx, filter, findOptions).Decode(&cp)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return Checkpoint{}, false, nil
+		}
+		return Checkpoint{}, false, fmt.Errorf("failed to retrieve checkpoint for %s from DB: %w", resource, err)
+	}
+	return cp, true, nil
+}
+
+// AbandonCheckpoints marks every unfinished run of a resource as abandoned,
+// so a restarted sync never resumes from them.
+func (r *MongoCheckpointRepository) AbandonCheckpoints(ctx context.Context, resource string) error {
+	filter := bson.M{"resource": resource, "completed": false, "abandoned": false}
+	update := bson.M{"$set": bson.M{"abandoned": true, "updated_at": time.Now().Unix()}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to abandon checkpoints for %s: %w", resource, err)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package pokeapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	cacheBodyExt = ".json"
+	cacheMetaExt = ".meta"
+
+	// Temp files and half-written entries younger than this may belong to a
+	// sync that is still writing them, so PruneCache leaves them alone.
+	pruneGracePeriod = 10 * time.Minute
+)
+
+// cacheEntry holds the validators needed to revalidate a cached response.
+type cacheEntry struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	Size         int64  `json:"size"`
+	StoredAt     int64  `json:"stored_at"`
+}
+
+// responseCache is an on-disk store of PokeAPI response bodies keyed by URL.
+// A cached body is never served without asking upstream first: the client
+// sends If-None-Match / If-Modified-Since and only reuses it on a 304.
+type responseCache struct {
+	dir string
+}
+
+// newResponseCache returns nil (cache disabled) when dir is empty.
+func newResponseCache(dir string) (*responseCache, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create PokeAPI cache dir %s: %w", dir, err)
+	}
+	return &responseCache{dir: dir}, nil
+}
+
+func (c *responseCache) path(url, ext string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+ext)
+}
+
+// lookup returns the stored validators for url, if any. Validators are only
+// returned while the body is on disk too, so a 304 can be served from it.
+func (c *responseCache) lookup(url string) (cacheEntry, bool) {
+	if info, err := os.Stat(c.path(url, cacheBodyExt)); err != nil || info.IsDir() {
+		return cacheEntry{}, false
+	}
+	raw, err := os.ReadFile(c.path(url, cacheMetaExt))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil || entry.URL != url {
+		return cacheEntry{}, false
+	}
+	if entry.ETag == "" && entry.LastModified == "" {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+// applyValidators adds the conditional headers for a previously cached response.
+func (entry cacheEntry) applyValidators(req *http.Request) {
+	if entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+}
+
+// load reads the cached body for url and bumps its modification time,
+// so pruning evicts the least recently used entries first.
+func (c *responseCache) load(url string) ([]byte, error) {
+	bodyPath := c.path(url, cacheBodyExt)
+	body, err := os.ReadFile(bodyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cached response for %s: %w", url, err)
+	}
+	now := time.Now()
+	_ = os.Chtimes(bodyPath, now, now)
+	_ = os.Chtimes(c.path(url, cacheMetaExt), now, now)
+	return body, nil
+}
+
+// drop removes the cached body and validators for url.
+func (c *responseCache) drop(url string) {
+	os.Remove(c.path(url, cacheMetaExt))
+	os.Remove(c.path(url, cacheBodyExt))
+}
+
+// store saves body and its validators. Responses without an ETag or
+// Last-Modified header cannot be revalidated and are not cached.
+func (c *responseCache) store(url string, header http.Header, body []byte) error {
+	entry := cacheEntry{
+		URL:          url,
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+		Size:         int64(len(body)),
+		StoredAt:     time.Now().Unix(),
+	}
+	if entry.ETag == "" && entry.LastModified == "" {
+		return nil
+	}
+
+	meta, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	// Body dulu, baru meta: meta tanpa body tidak pernah terlihat oleh lookup
+	if err := writeFileAtomic(c.path(url, cacheBodyExt), body); err != nil {
+		return fmt.Errorf("failed to cache response for %s: %w", url, err)
+	}
+	if err := writeFileAtomic(c.path(url, cacheMetaExt), meta); err != nil {
+		return fmt.Errorf("failed to cache validators for %s: %w", url, err)
+	}
+	return nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// PruneResult reports what PruneCache removed.
+type PruneResult struct {
+	EntriesRemoved int
+	BytesRemoved   int64
+	EntriesKept    int
+	BytesKept      int64
+}
+
+// PruneCache shrinks the response cache in dir to at most maxBytes by evicting
+// the least recently used entries. A maxBytes of 0 empties the cache.
+// Leftover temp files and bodies without validators are removed once they are
+// older than pruneGracePeriod.
+func PruneCache(dir string, maxBytes int64) (PruneResult, error) {
+	var result PruneResult
+	graceCutoff := time.Now().Add(-pruneGracePeriod)
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, nil
+		}
+		return result, fmt.Errorf("failed to read PokeAPI cache dir %s: %w", dir, err)
+	}
+
+	type entryFiles struct {
+		key     string
+		size    int64
+		modTime time.Time
+		hasMeta bool
+		hasBody bool
+	}
+	entries := make(map[string]*entryFiles)
+
+	for _, file := range files {
+		name := file.Name()
+		info, err := file.Info()
+		if err != nil || file.IsDir() {
+			continue
+		}
+
+		ext := filepath.Ext(name)
+		if strings.Contains(name, ".tmp-") || (ext != cacheBodyExt && ext != cacheMetaExt) {
+			// Temp file yang masih baru mungkin sedang ditulis oleh proses lain
+			if info.ModTime().After(graceCutoff) {
+				continue
+			}
+			if os.Remove(filepath.Join(dir, name)) == nil {
+				result.BytesRemoved += info.Size()
+			}
+			continue
+		}
+
+		key := strings.TrimSuffix(name, ext)
+		entry, ok := entries[key]
+		if !ok {
+			entry = &entryFiles{key: key}
+			entries[key] = entry
+		}
+		entry.size += info.Size()
+		if info.ModTime().After(entry.modTime) {
+			entry.modTime = info.ModTime()
+		}
+		if ext == cacheMetaExt {
+			entry.hasMeta = true
+		} else {
+			entry.hasBody = true
+		}
+	}
+
+	sorted := make([]*entryFiles, 0, len(entries))
+	for _, entry := range entries {
+		sorted = append(sorted, entry)
+	}
+	// Paling baru dipakai di depan
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].modTime.After(sorted[j].modTime)
+	})
+
+	for _, entry := range sorted {
+		complete := entry.hasMeta && entry.hasBody
+		if !complete && entry.modTime.After(graceCutoff) {
+			continue
+		}
+		if complete && result.BytesKept+entry.size <= maxBytes {
+			result.EntriesKept++
+			result.BytesKept += entry.size
+			continue
+		}
+
+		os.Remove(filepath.Join(dir, entry.key+cacheBodyExt))
+		os.Remove(filepath.Join(dir, entry.key+cacheMetaExt))
+		result.EntriesRemoved++
+		result.BytesRemoved += entry.size
+	}
+
+	return result, nil
+}
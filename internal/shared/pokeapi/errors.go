@@ -0,0 +1,140 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultRetryAfter is used when a 429 response carries no usable Retry-After header.
+const defaultRetryAfter = 3 * time.Second
+
+// RateLimitError is returned when PokeAPI answers 429 Too Many Requests.
+// RetryAfter is how long the upstream asked us to wait.
+type RateLimitError struct {
+	URL        string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited by PokeAPI for %s (retry after %v)", e.URL, e.RetryAfter)
+}
+
+// NotFoundError is returned when the requested resource does not exist upstream.
+type NotFoundError struct {
+	URL string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("PokeAPI resource not found: %s", e.URL)
+}
+
+// UpstreamError is returned for any other non-200 response.
+// Only 5xx responses are considered transient.
+type UpstreamError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("API responded with status %d for %s: %s", e.StatusCode, e.URL, e.Status)
+}
+
+// TransportError wraps network failures (DNS, connection reset, timeouts).
+type TransportError struct {
+	URL string
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("failed to make HTTP request to %s: %v", e.URL, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError is returned when the response body is not the JSON we expect.
+type DecodeError struct {
+	URL string
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode JSON response from %s: %v", e.URL, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryable reports whether err is transient, i.e. the same request may succeed later.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var rateLimitErr *RateLimitError
+	var upstreamErr *UpstreamError
+	var transportErr *TransportError
+	switch {
+	case errors.As(err, &rateLimitErr):
+		return true
+	case errors.As(err, &upstreamErr):
+		return upstreamErr.StatusCode >= http.StatusInternalServerError
+	case errors.As(err, &transportErr):
+		return true
+	}
+	return false
+}
+
+// RetryAfter returns the wait duration carried by a RateLimitError, or 0 for any other error.
+func RetryAfter(err error) time.Duration {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return rateLimitErr.RetryAfter
+	}
+	return 0
+}
+
+// parseRetryAfter accepts both forms allowed by RFC 9110: delay-seconds and an HTTP-date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return defaultRetryAfter
+}
+
+// ErrorClass returns a short, stable name for the kind of err,
+// suitable for storing and grouping failures.
+func ErrorClass(err error) string {
+	var rateLimitErr *RateLimitError
+	var notFoundErr *NotFoundError
+	var upstreamErr *UpstreamError
+	var transportErr *TransportError
+	var decodeErr *DecodeError
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return "canceled"
+	case errors.As(err, &rateLimitErr):
+		return "rate_limited"
+	case errors.As(err, &notFoundErr):
+		return "not_found"
+	case errors.As(err, &upstreamErr):
+		return "upstream"
+	case errors.As(err, &transportErr):
+		return "transport"
+	case errors.As(err, &decodeErr):
+		return "decode"
+	}
+	return "unknown"
+}
@@ -4,18 +4,32 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"math/rand"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"pokedex/config"
 	modelability "pokedex/internal/ability/model"
+	modelberry "pokedex/internal/berry/model"
+	modelegggroup "pokedex/internal/egg-group/model"
+	modelencounter "pokedex/internal/encounter/model"
 	modelevolution "pokedex/internal/evolution/model"
+	modelgeneration "pokedex/internal/generation/model"
+	modelgrowthrate "pokedex/internal/growth-rate/model"
+	modelitem "pokedex/internal/item/model"
+	modelmove "pokedex/internal/move/model"
+	modelnature "pokedex/internal/nature/model"
+	modelpokedex "pokedex/internal/pokedex/model"
+	modelpokemonform "pokedex/internal/pokemon-form/model"
 	modelpokemonspecies "pokedex/internal/pokemon-species/model"
 	model_pokemon_type "pokedex/internal/pokemon-type/model"
 	modelpokemon "pokedex/internal/pokemon/model"
+	"pokedex/utils"
 )
 
 var (
@@ -24,54 +38,127 @@ var (
 	requestQueue   chan func()
 	wg             sync.WaitGroup
 	once           sync.Once
-	rateLimitMs    time.Duration
+	limiter        *tokenBucket
+	cache          *responseCache
+	cacheMaxBytes  int64
+	maxRetries     int
+	retryBase      time.Duration
+	activeSource   Source
 )
 
+// maxRetryBackoff caps the exponential backoff between two attempts.
+const maxRetryBackoff = 30 * time.Second
+
 type Client struct{} // Our shared PokeAPI Client
 
 func NewClient(cfg *config.Config) *Client {
 	once.Do(func() {
 		pokeAPIBaseURL = cfg.PokeAPIURL
+
+		// Offline dump: requests bypass the queue, so no rate limit applies
+		if strings.HasPrefix(cfg.PokeAPISource, "file://") {
+			fileSrc, err := newFileSource(strings.TrimPrefix(cfg.PokeAPISource, "file://"))
+			if err != nil {
+				log.Fatalf("Failed to open PokeAPI data dump: %v", err)
+			}
+			log.Printf("Reading PokeAPI resources from local dump %s\n", fileSrc.root)
+			activeSource = fileSrc
+		} else {
+			if cfg.PokeAPISource != "http" {
+				log.Printf("Warning: unknown POKEAPI_SOURCE %q, using the live PokeAPI.\n", cfg.PokeAPISource)
+			}
+			activeSource = httpSource{}
+		}
+
 		httpClient = &http.Client{
 			Timeout: 10 * time.Second,
 		}
 		requestQueue = make(chan func(), 100)
-		rateLimitMs = time.Duration(cfg.RateLimitMs) * time.Millisecond
+		limiter = newTokenBucket(time.Duration(cfg.RateLimitMs)*time.Millisecond, cfg.PokeAPIRateBurst)
+		maxRetries = cfg.PokeAPIMaxRetries
+		retryBase = time.Duration(cfg.PokeAPIRetryBaseMs) * time.Millisecond
+
+		var err error
+		cache, err = newResponseCache(cfg.PokeAPICacheDir)
+		if err != nil {
+			log.Printf("Warning: PokeAPI response cache disabled: %v\n", err)
+		}
+		cacheMaxBytes = int64(cfg.PokeAPICacheMaxMB) * 1024 * 1024
 
-		go processQueue()
+		workers := cfg.PokeAPIWorkers
+		if workers < 1 {
+			workers = 1
+		}
+		for i := 0; i < workers; i++ {
+			go processQueue(i)
+		}
 	})
 	return &Client{}
 }
 
-func processQueue() {
-	ticker := time.NewTicker(rateLimitMs)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case task, ok := <-requestQueue:
-			if !ok {
-				log.Println("PokeAPI request queue closed. Stopping processor.")
-				return
-			}
-			task()
-			<-ticker.C
-		}
+// processQueue is a single worker. Workers run queued requests concurrently;
+// the shared token bucket (not the worker count) decides the request rate.
+func processQueue(workerID int) {
+	for task := range requestQueue {
+		task()
 	}
+	log.Printf("PokeAPI request queue closed. Stopping worker %d.\n", workerID)
 }
 
 func (c *Client) CloseClient() {
 	close(requestQueue)
 	wg.Wait()
+
+	// Jaga ukuran cache tetap di bawah batas setelah setiap run
+	if cache != nil {
+		result, err := PruneCache(cache.dir, cacheMaxBytes)
+		if err != nil {
+			log.Printf("Warning: failed to prune PokeAPI cache: %v\n", err)
+		} else if result.EntriesRemoved > 0 {
+			log.Printf("Pruned %d PokeAPI cache entries (%d bytes).\n", result.EntriesRemoved, result.BytesRemoved)
+		}
+	}
+
 	log.Println("All queued PokeAPI requests processed and client closed.")
 }
 
-func (c *Client) enqueueAndFetch(ctx context.Context, url string, target interface{}) error {
+// fetch reads url from whichever Source the client was configured with.
+func (c *Client) fetch(ctx context.Context, url string, target interface{}) error {
+	return activeSource.Fetch(ctx, url, target)
+}
+
+// Fetch runs the request through the rate-limited queue, retrying
+// transient failures (429, 5xx, network errors) with exponential backoff and jitter.
+// A Retry-After sent by PokeAPI always takes precedence over the computed backoff.
+func (s httpSource) Fetch(ctx context.Context, url string, target interface{}) error {
+	for attempt := 0; ; attempt++ {
+		err := s.enqueueOnce(ctx, url, target)
+		if err == nil || !IsRetryable(err) || attempt >= maxRetries {
+			return err
+		}
+
+		wait := retryDelay(attempt, err)
+		log.Printf("Retrying %s in %v (attempt %d/%d): %v\n", url, wait, attempt+1, maxRetries, err)
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s httpSource) enqueueOnce(ctx context.Context, url string, target interface{}) error {
 	resultChan := make(chan error, 1)
 	wg.Add(1)
 	requestQueue <- func() {
 		defer wg.Done()
-		err := c.fetchAndDecode(ctx, url, target)
+		// Tunggu token dari rate limiter sebelum benar-benar mengirim request
+		if err := limiter.Wait(ctx); err != nil {
+			resultChan <- err
+			return
+		}
+		err := s.fetchAndDecode(ctx, url, target)
 		resultChan <- err
 	}
 
@@ -83,140 +170,340 @@ func (c *Client) enqueueAndFetch(ctx context.Context, url string, target interfa
 	}
 }
 
-func (c *Client) fetchAndDecode(ctx context.Context, url string, target interface{}) error {
+// retryDelay returns the wait before retry number attempt+1.
+func retryDelay(attempt int, err error) time.Duration {
+	if retryAfter := RetryAfter(err); retryAfter > 0 {
+		// Sedikit jitter supaya semua worker tidak menembak bersamaan setelah Retry-After
+		return retryAfter + time.Duration(rand.Int63n(int64(time.Second)))
+	}
+
+	backoff := retryBase << attempt
+	if backoff <= 0 || backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	// Equal jitter: anywhere between half and the whole backoff
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func (s httpSource) fetchAndDecode(ctx context.Context, url string, target interface{}) error {
+	return s.fetch(ctx, url, target, true)
+}
+
+// fetch GETs url into target. With conditional set, cached validators are sent
+// so upstream can answer 304 and the body is served from the response cache.
+func (s httpSource) fetch(ctx context.Context, url string, target interface{}, conditional bool) error {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
+	// Kirim validator dari cache supaya upstream bisa menjawab 304
+	var cached bool
+	if cache != nil && conditional {
+		var entry cacheEntry
+		if entry, cached = cache.lookup(url); cached {
+			entry.applyValidators(req)
+		}
+	}
+
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		if ctx.Err() == context.Canceled {
+		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-		return fmt.Errorf("failed to make HTTP request: %w", err)
+		return &TransportError{URL: url, Err: err}
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusTooManyRequests {
-		retryAfter := resp.Header.Get("Retry-After")
-		waitDuration := 3 * time.Second
-		if sleepTime, parseErr := strconv.Atoi(retryAfter); parseErr == nil && sleepTime > 0 {
-			waitDuration = time.Duration(sleepTime) * time.Second
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached:
+		body, err := cache.load(url)
+		if err != nil {
+			// Body hilang setelah lookup (mis. cache-prune berjalan): buang entry dan minta ulang
+			log.Printf("Warning: %v, fetching %s again without validators\n", err, url)
+			cache.drop(url)
+			return s.fetch(ctx, url, target, false)
 		}
-		log.Printf("Rate limit (429) hit for %s. Waiting %v before potentially retrying.\n", url, waitDuration)
-		return fmt.Errorf("rate_limit_hit") // Use string for error since we don't have domain errors here. Can be improved.
+		// Not modified: serve from disk and don't charge it to the rate budget
+		limiter.Refund()
+		return decodeBody(url, body, target)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		waitDuration := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		log.Printf("Rate limit (429) hit for %s. Pausing all workers for %v.\n", url, waitDuration)
+		limiter.PauseFor(waitDuration)
+		return &RateLimitError{URL: url, RetryAfter: waitDuration}
+	case resp.StatusCode == http.StatusNotFound:
+		return &NotFoundError{URL: url}
+	case resp.StatusCode != http.StatusOK:
+		return &UpstreamError{URL: url, StatusCode: resp.StatusCode, Status: resp.Status}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API responded with status %d: %s", resp.StatusCode, resp.Status)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return &TransportError{URL: url, Err: err}
+	}
+
+	if err := decodeBody(url, body, target); err != nil {
+		return err
 	}
 
-	decoder := json.NewDecoder(resp.Body)
-	if err := decoder.Decode(target); err != nil {
-		return fmt.Errorf("failed to decode JSON response: %w", err)
+	if cache != nil {
+		if err := cache.store(url, resp.Header, body); err != nil {
+			log.Printf("Warning: %v\n", err)
+		}
 	}
 
 	return nil
 }
 
-// --- FUNGSI BARU UNTUK POKEMON ---
+func decodeBody(url string, body []byte, target interface{}) error {
+	if err := json.Unmarshal(body, target); err != nil {
+		return &DecodeError{URL: url, Err: err}
+	}
+	return nil
+}
 
-// FetchPokemonList fetches a list of Pokémon from PokeAPI.
-func (c *Client) FetchPokemonList(ctx context.Context, limit, offset int) (modelpokemon.PokemonListResponse, error) {
-	url := pokeAPIBaseURL + "/pokemon?limit=" + strconv.Itoa(limit) + "&offset=" + strconv.Itoa(offset)
+// NamedResource is an entry of a PokeAPI list endpoint.
+type NamedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ResourceList is one page of a PokeAPI list endpoint.
+type ResourceList struct {
+	Count    int             `json:"count"`
+	Next     *string         `json:"next"`
+	Previous *string         `json:"previous"`
+	Results  []NamedResource `json:"results"`
+}
+
+// FetchResourceList fetches one page of any PokeAPI list endpoint, e.g. "pokemon" or "evolution-chain".
+func (c *Client) FetchResourceList(ctx context.Context, endpoint string, limit, offset int) (ResourceList, error) {
+	url := pokeAPIBaseURL + "/" + endpoint + "?limit=" + strconv.Itoa(limit) + "&offset=" + strconv.Itoa(offset)
 	log.Printf("Enqueueing list fetch from PokeAPI: %s\n", url)
 
-	var response modelpokemon.PokemonListResponse
-	err := c.enqueueAndFetch(ctx, url, &response)
+	var response ResourceList
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
 
+// --- FUNGSI BARU UNTUK POKEMON ---
+
 // FetchPokemonDetail fetches details for a single Pokemon from PokeAPI.
 func (c *Client) FetchPokemonDetail(ctx context.Context, url string) (modelpokemon.PokemonDetail, error) {
 	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
 
 	var response modelpokemon.PokemonDetail
-	err := c.enqueueAndFetch(ctx, url, &response)
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
 
 // --- FUNGSI BARU UNTUK POKEMON SPECIES ---
 
-// FetchPokemonList fetches a list of Pokémon from PokeAPI.
-func (c *Client) FetchPokemonSpeciesList(ctx context.Context, limit, offset int) (modelpokemonspecies.PokemonSpeciesListResponse, error) {
-	url := pokeAPIBaseURL + "/pokemon-species?limit=" + strconv.Itoa(limit) + "&offset=" + strconv.Itoa(offset)
-	log.Printf("Enqueueing list fetch from PokeAPI: %s\n", url)
-
-	var response modelpokemonspecies.PokemonSpeciesListResponse
-	err := c.enqueueAndFetch(ctx, url, &response)
-	return response, err
-}
-
 // FetchPokemonDetail fetches details for a single Pokemon from PokeAPI.
 func (c *Client) FetchPokemonSpeciesDetail(ctx context.Context, url string) (modelpokemonspecies.PokemonSpeciesDetail, error) {
 	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
 
 	var response modelpokemonspecies.PokemonSpeciesDetail
-	err := c.enqueueAndFetch(ctx, url, &response)
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
 
 // --- FUNGSI BARU UNTUK ABILITY ---
 
-// FetchAbilityList fetches a paginated list of abilities.
-func (c *Client) FetchAbilityList(ctx context.Context, limit, offset int) (modelability.AbilityListResponse, error) {
-	url := pokeAPIBaseURL + "/ability?limit=" + strconv.Itoa(limit) + "&offset=" + strconv.Itoa(offset)
-	log.Printf("Enqueueing list fetch from PokeAPI: %s\n", url)
-
-	var response modelability.AbilityListResponse
-	err := c.enqueueAndFetch(ctx, url, &response)
-	return response, err
-}
-
 // FetchAbilityDetail fetches a single ability detail by its URL.
 func (c *Client) FetchAbilityDetail(ctx context.Context, url string) (modelability.AbilityDetail, error) {
 	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
 
 	var response modelability.AbilityDetail
-	err := c.enqueueAndFetch(ctx, url, &response)
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
 
 // --- FUNGSI BARU UNTUK EVOLUTION ---
 
-func (c *Client) FetchEvolutionList(ctx context.Context, limit, offset int) (modelevolution.EvolutionListResponse, error) {
-	url := pokeAPIBaseURL + "/evolution-chain?limit=" + strconv.Itoa(limit) + "&offset=" + strconv.Itoa(offset)
-	log.Printf("Enqueueing list fetch from PokeAPI: %s\n", url)
+func (c *Client) FetchEvolutionDetail(ctx context.Context, url string) (modelevolution.EvolutionChain, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
 
-	var response modelevolution.EvolutionListResponse
-	err := c.enqueueAndFetch(ctx, url, &response)
+	var response modelevolution.EvolutionChain
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
 
-func (c *Client) FetchEvolutionDetail(ctx context.Context, url string) (modelevolution.EvolutionChain, error) {
+// --- FUNGSI BARU UNTUK POKEMON TYPE ---
+
+func (c *Client) FetchPokemonTypeDetail(ctx context.Context, url string) (model_pokemon_type.PokemonTypeDetailResponse, error) {
 	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
 
-	var response modelevolution.EvolutionChain
-	err := c.enqueueAndFetch(ctx, url, &response)
+	var response model_pokemon_type.PokemonTypeDetailResponse
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
 
-// --- FUNGSI BARU UNTUK POKEMON TYPE ---
+// --- FUNGSI BARU UNTUK MOVE ---
 
-func (c *Client) FetchPokemonTypeList(ctx context.Context, limit, offset int) (model_pokemon_type.PokemonListTypeResponse, error) {
-	url := pokeAPIBaseURL + "/type?limit=" + strconv.Itoa(limit) + "&offset=" + strconv.Itoa(offset)
-	log.Printf("Enqueueing list fetch from PokeAPI: %s\n", url)
+// FetchMoveDetail fetches a single move detail by its URL.
+func (c *Client) FetchMoveDetail(ctx context.Context, url string) (modelmove.MoveDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
 
-	var response model_pokemon_type.PokemonListTypeResponse
-	err := c.enqueueAndFetch(ctx, url, &response)
+	var response modelmove.MoveDetail
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
 
-func (c *Client) FetchPokemonTypeDetail(ctx context.Context, url string) (model_pokemon_type.PokemonTypeDetailResponse, error) {
+// FetchItemDetail fetches a single item detail by its URL.
+func (c *Client) FetchItemDetail(ctx context.Context, url string) (modelitem.ItemDetail, error) {
 	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
 
-	var response model_pokemon_type.PokemonTypeDetailResponse
-	err := c.enqueueAndFetch(ctx, url, &response)
+	var response modelitem.ItemDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// --- ENCOUNTERS & LOCATIONS ---
+
+// FetchPokemonEncounters fetches the encounters sub-resource of a pokemon, given the pokemon URL.
+func (c *Client) FetchPokemonEncounters(ctx context.Context, pokemonURL string) (modelencounter.PokemonEncounters, error) {
+	url := strings.TrimRight(pokemonURL, "/") + "/encounters"
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	// Endpoint ini mengembalikan array, bukan object
+	var response []modelencounter.LocationAreaEncounter
+	err := c.fetch(ctx, url, &response)
+	return modelencounter.PokemonEncounters{
+		PokemonID:  utils.ExtractIDFromURL(pokemonURL),
+		Encounters: response,
+	}, err
+}
+
+// FetchLocationDetail fetches a single location by its URL.
+func (c *Client) FetchLocationDetail(ctx context.Context, url string) (modelencounter.Location, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelencounter.Location
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchLocationAreaDetail fetches a single location area by its URL.
+func (c *Client) FetchLocationAreaDetail(ctx context.Context, url string) (modelencounter.LocationArea, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelencounter.LocationArea
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchEncounterMethodDetail fetches a single encounter method by its URL.
+func (c *Client) FetchEncounterMethodDetail(ctx context.Context, url string) (modelencounter.EncounterMethod, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelencounter.EncounterMethod
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchEncounterConditionDetail fetches a single encounter condition by its URL.
+func (c *Client) FetchEncounterConditionDetail(ctx context.Context, url string) (modelencounter.EncounterCondition, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelencounter.EncounterCondition
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchNatureDetail fetches a single nature by its URL.
+func (c *Client) FetchNatureDetail(ctx context.Context, url string) (modelnature.NatureDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelnature.NatureDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// --- GENERATIONS & VERSIONS ---
+
+// FetchGenerationDetail fetches a single generation by its URL.
+func (c *Client) FetchGenerationDetail(ctx context.Context, url string) (modelgeneration.GenerationDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelgeneration.GenerationDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchVersionGroupDetail fetches a single version group by its URL.
+func (c *Client) FetchVersionGroupDetail(ctx context.Context, url string) (modelgeneration.VersionGroupDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelgeneration.VersionGroupDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchVersionDetail fetches a single version by its URL.
+func (c *Client) FetchVersionDetail(ctx context.Context, url string) (modelgeneration.VersionDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelgeneration.VersionDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchPokedexDetail fetches a single pokedex by its URL.
+func (c *Client) FetchPokedexDetail(ctx context.Context, url string) (modelpokedex.PokedexDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelpokedex.PokedexDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchEggGroupDetail fetches a single egg group by its URL.
+func (c *Client) FetchEggGroupDetail(ctx context.Context, url string) (modelegggroup.EggGroupDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelegggroup.EggGroupDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchBerryDetail fetches a single berry by its URL.
+func (c *Client) FetchBerryDetail(ctx context.Context, url string) (modelberry.BerryDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelberry.BerryDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchBerryFlavorDetail fetches a single berry flavor by its URL.
+func (c *Client) FetchBerryFlavorDetail(ctx context.Context, url string) (modelberry.BerryFlavorDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelberry.BerryFlavorDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchGrowthRateDetail fetches a single growth rate by its URL.
+func (c *Client) FetchGrowthRateDetail(ctx context.Context, url string) (modelgrowthrate.GrowthRateDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelgrowthrate.GrowthRateDetail
+	err := c.fetch(ctx, url, &response)
+	return response, err
+}
+
+// FetchPokemonFormDetail fetches a single pokemon form by its URL.
+func (c *Client) FetchPokemonFormDetail(ctx context.Context, url string) (modelpokemonform.PokemonFormDetail, error) {
+	log.Printf("Enqueueing detail fetch from PokeAPI: %s\n", url)
+
+	var response modelpokemonform.PokemonFormDetail
+	err := c.fetch(ctx, url, &response)
 	return response, err
 }
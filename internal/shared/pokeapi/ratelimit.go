@@ -0,0 +1,102 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a small token-bucket limiter shared by all client workers.
+// It holds at most burst tokens and gains one token every interval, so short
+// bursts go out immediately while the sustained rate stays at 1/interval.
+type tokenBucket struct {
+	mu          sync.Mutex
+	tokens      float64
+	burst       float64
+	interval    time.Duration
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(interval time.Duration, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		tokens:   float64(burst),
+		burst:    float64(burst),
+		interval: interval,
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		wait := b.reserve(time.Now())
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token if one is available and returns 0, otherwise it
+// returns how long the caller should sleep before trying again.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+
+	if b.interval <= 0 {
+		return 0 // rate limiting disabled
+	}
+
+	elapsed := now.Sub(b.last)
+	b.last = now
+	b.tokens += float64(elapsed) / float64(b.interval)
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) * float64(b.interval))
+}
+
+// PauseFor stops every worker from sending requests for d and drains the
+// bucket, so traffic resumes at the sustained rate rather than in a burst.
+func (b *tokenBucket) PauseFor(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	b.tokens = 0
+}
+
+// Refund gives back a token for a request that turned out not to cost
+// upstream anything worth budgeting for (e.g. a 304 served from the cache).
+func (b *tokenBucket) Refund() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
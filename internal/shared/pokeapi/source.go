@@ -0,0 +1,133 @@
+package pokeapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Source is where the Client reads PokeAPI resources from. Every Fetch* method
+// of the Client goes through the configured Source, so the sync services work
+// the same against the live API and against a local dump.
+type Source interface {
+	Fetch(ctx context.Context, url string, target interface{}) error
+}
+
+// httpSource talks to the live PokeAPI through the rate-limited worker queue.
+type httpSource struct{}
+
+// fileSource reads a local copy of the PokeAPI api-data JSON tree
+// (https://github.com/PokeAPI/api-data), where every resource lives at
+// <root>/api/v2/<resource>/<id>/index.json and every list at
+// <root>/api/v2/<resource>/index.json. No rate limiting is applied.
+type fileSource struct {
+	root string
+}
+
+func newFileSource(root string) (*fileSource, error) {
+	// Terima root repo api-data (yang berisi data/api/v2) maupun folder data-nya langsung
+	for _, candidate := range []string{root, filepath.Join(root, "data")} {
+		if info, err := os.Stat(filepath.Join(candidate, "api", "v2")); err == nil && info.IsDir() {
+			return &fileSource{root: candidate}, nil
+		}
+	}
+	return nil, fmt.Errorf("no api/v2 directory found under %s", root)
+}
+
+// Fetch maps a PokeAPI URL onto the dump and decodes it into target.
+// List URLs (those with limit/offset) are paginated locally from the full index.
+func (s *fileSource) Fetch(ctx context.Context, rawURL string, target interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid PokeAPI URL %s: %w", rawURL, err)
+	}
+
+	resourcePath := parsed.Path
+	if i := strings.Index(resourcePath, "/api/v2/"); i >= 0 {
+		resourcePath = resourcePath[i+len("/api/v2/"):]
+	}
+	resourcePath = strings.Trim(resourcePath, "/")
+
+	body, err := os.ReadFile(filepath.Join(s.root, "api", "v2", filepath.FromSlash(resourcePath), "index.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &NotFoundError{URL: rawURL}
+		}
+		return fmt.Errorf("failed to read %s from PokeAPI dump: %w", rawURL, err)
+	}
+
+	body = absolutizeURLs(body)
+
+	query := parsed.Query()
+	if query.Has("limit") || query.Has("offset") {
+		body, err = paginate(body, rawURL, query)
+		if err != nil {
+			return err
+		}
+	}
+
+	return decodeBody(rawURL, body, target)
+}
+
+// absolutizeURLs rewrites the dump's relative "/api/v2/..." references to the
+// configured PokeAPI base URL, so stored documents look exactly like an HTTP sync.
+func absolutizeURLs(body []byte) []byte {
+	base := strings.TrimSuffix(pokeAPIBaseURL, "/")
+	base = strings.TrimSuffix(base, "/api/v2")
+	return bytes.ReplaceAll(body, []byte(`"/api/v2/`), []byte(`"`+base+`/api/v2/`))
+}
+
+// paginate slices a full resource index the same way PokeAPI's limit/offset does.
+func paginate(body []byte, rawURL string, query url.Values) ([]byte, error) {
+	var index struct {
+		Count   int               `json:"count"`
+		Results []json.RawMessage `json:"results"`
+	}
+	if err := json.Unmarshal(body, &index); err != nil {
+		return nil, &DecodeError{URL: rawURL, Err: err}
+	}
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20 // default PokeAPI
+	}
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	start := min(offset, len(index.Results))
+	end := min(offset+limit, len(index.Results))
+
+	page := struct {
+		Count    int               `json:"count"`
+		Next     *string           `json:"next"`
+		Previous *string           `json:"previous"`
+		Results  []json.RawMessage `json:"results"`
+	}{
+		Count:   len(index.Results),
+		Results: index.Results[start:end],
+	}
+
+	base := strings.SplitN(rawURL, "?", 2)[0]
+	if end < len(index.Results) {
+		next := fmt.Sprintf("%s?limit=%d&offset=%d", base, limit, end)
+		page.Next = &next
+	}
+	if offset > 0 {
+		previous := fmt.Sprintf("%s?limit=%d&offset=%d", base, limit, max(offset-limit, 0))
+		page.Previous = &previous
+	}
+
+	return json.Marshal(page)
+}
@@ -0,0 +1,225 @@
+package spritemirror
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"pokedex/config"
+)
+
+// UpstreamPrefix is the root of every PokeAPI sprite URL. The mirror keeps the
+// path below it, e.g. pokemon/other/official-artwork/25.png.
+const UpstreamPrefix = "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/"
+
+// CriesUpstreamPrefix is the root of every PokeAPI cry URL. Cries are kept
+// below cries/ in the mirror, e.g. cries/pokemon/latest/25.ogg.
+const CriesUpstreamPrefix = "https://raw.githubusercontent.com/PokeAPI/cries/main/"
+
+// RoutePath is where the API serves SpriteMirrorDir itself.
+const RoutePath = "/assets/sprites"
+
+var (
+	dir     string
+	baseURL string
+)
+
+// Configure enables mirroring when cfg.SpriteMirrorDir is set. Like database.ConnectDB
+// it is called once at startup, before any sprite URL is built.
+func Configure(cfg *config.Config) {
+	dir = cfg.SpriteMirrorDir
+	baseURL = strings.TrimRight(cfg.SpriteMirrorBaseURL, "/")
+}
+
+// Enabled reports whether sprite URLs are rewritten to the mirror.
+func Enabled() bool {
+	return dir != ""
+}
+
+// Dir returns the directory holding the mirrored sprites.
+func Dir() string {
+	return dir
+}
+
+// RelPath returns the path of an upstream sprite or cry inside the mirror.
+// It returns false for URLs that do not point at the PokeAPI sprites or cries.
+func RelPath(url string) (string, bool) {
+	var rel string
+	switch {
+	case strings.HasPrefix(url, UpstreamPrefix):
+		rel = strings.TrimPrefix(url, UpstreamPrefix)
+	case strings.HasPrefix(url, CriesUpstreamPrefix):
+		rel = strings.TrimPrefix(url, CriesUpstreamPrefix)
+	default:
+		return "", false
+	}
+	rel = path.Clean(rel)
+	if rel == "." || strings.HasPrefix(rel, "../") {
+		return "", false
+	}
+	return rel, true
+}
+
+// URL returns the mirrored URL of an upstream sprite when mirroring is enabled,
+// and url unchanged otherwise.
+func URL(url string) string {
+	if !Enabled() {
+		return url
+	}
+	rel, ok := RelPath(url)
+	if !ok {
+		return url
+	}
+	return baseURL + "/" + rel
+}
+
+// LocalPath returns the mirrored file of an upstream sprite or cry when mirroring
+// is enabled and the file has been downloaded.
+func LocalPath(url string) (string, bool) {
+	if !Enabled() {
+		return "", false
+	}
+	rel, ok := RelPath(url)
+	if !ok {
+		return "", false
+	}
+	local := filepath.Join(dir, filepath.FromSlash(rel))
+	if info, err := os.Stat(local); err != nil || info.IsDir() {
+		return "", false
+	}
+	return local, true
+}
+
+// Ptr is URL for the nullable sprite fields.
+func Ptr(url *string) *string {
+	if url == nil {
+		return nil
+	}
+	mirrored := URL(*url)
+	return &mirrored
+}
+
+// Result counts what one Download run did.
+type Result struct {
+	Downloaded int
+	Skipped    int
+	Failed     int
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("downloaded=%d skipped=%d failed=%d", r.Downloaded, r.Skipped, r.Failed)
+}
+
+// Download stores every sprite or cry in urls below the local directory dir,
+// skipping files already mirrored unless force is set. Failed downloads are
+// logged and counted; they do not stop the run.
+func Download(ctx context.Context, dir string, urls []string, workers int, force bool) (Result, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return Result{}, fmt.Errorf("failed to create sprite mirror dir %s: %w", dir, err)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	jobs := make(chan string)
+
+	var (
+		mu     sync.Mutex
+		result Result
+		wg     sync.WaitGroup
+	)
+	count := func(field *int) {
+		mu.Lock()
+		*field++
+		mu.Unlock()
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for url := range jobs {
+				rel, _ := RelPath(url)
+				target := filepath.Join(dir, filepath.FromSlash(rel))
+				if !force {
+					if _, err := os.Stat(target); err == nil {
+						count(&result.Skipped)
+						continue
+					}
+				}
+				if err := downloadFile(ctx, client, url, target); err != nil {
+					log.Printf("Failed to mirror %s: %v\n", url, err)
+					count(&result.Failed)
+					continue
+				}
+				count(&result.Downloaded)
+			}
+		}()
+	}
+
+	seen := make(map[string]bool, len(urls))
+feed:
+	for _, url := range urls {
+		if _, ok := RelPath(url); !ok || seen[url] {
+			continue
+		}
+		seen[url] = true
+		select {
+		case jobs <- url:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return result, ctx.Err()
+}
+
+// downloadFile writes url to target through a temporary file, so a crash never
+// leaves a truncated file that later runs would skip.
+func downloadFile(ctx context.Context, client *http.Client, url, target string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
@@ -0,0 +1,97 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"pokedex/internal/shared/checkpoint"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Checkpointer tracks a single resumable pass over a paginated resource.
+// A nil repository turns every method into a no-op, so callers never need to branch.
+type Checkpointer struct {
+	repo    checkpoint.CheckpointRepository
+	current checkpoint.Checkpoint
+	resumed bool
+}
+
+// StartCheckpoint resumes the last unfinished run of resource, or begins a new
+// run at offset 0 when there is none or opts.Restart is set.
+func StartCheckpoint(ctx context.Context, opts Options, resource string) (*Checkpointer, error) {
+	cp := &Checkpointer{
+		repo: opts.Checkpoints,
+		current: checkpoint.Checkpoint{
+			Resource:  resource,
+			RunID:     primitive.NewObjectID().Hex(),
+			StartedAt: time.Now().Unix(),
+		},
+	}
+	if cp.repo == nil {
+		return cp, nil
+	}
+
+	if opts.Restart {
+		if err := cp.repo.AbandonCheckpoints(ctx, resource); err != nil {
+			return nil, err
+		}
+		log.Printf("Restarting %s sync from offset 0 (run %s).\n", resource, cp.current.RunID)
+	} else {
+		previous, found, err := cp.repo.GetResumableCheckpoint(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			cp.current = previous
+			cp.resumed = true
+			log.Printf("Resuming %s sync from offset %d (run %s).\n", resource, previous.Offset, previous.RunID)
+		}
+	}
+
+	if err := cp.repo.SaveCheckpoint(ctx, cp.current); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// Offset returns the offset the sync should start (or continue) from.
+func (c *Checkpointer) Offset() int {
+	return c.current.Offset
+}
+
+// RunID identifies the run across restarts of the process.
+func (c *Checkpointer) RunID() string {
+	return c.current.RunID
+}
+
+// Resumed reports whether this run picked up an earlier unfinished run.
+func (c *Checkpointer) Resumed() bool {
+	return c.resumed
+}
+
+// Advance records that every batch before nextOffset has been processed.
+func (c *Checkpointer) Advance(ctx context.Context, nextOffset int) error {
+	c.current.Offset = nextOffset
+	if c.repo == nil {
+		return nil
+	}
+	if err := c.repo.SaveCheckpoint(ctx, c.current); err != nil {
+		return fmt.Errorf("failed to advance %s checkpoint: %w", c.current.Resource, err)
+	}
+	return nil
+}
+
+// Complete marks the run as finished so the next sync starts from the beginning.
+func (c *Checkpointer) Complete(ctx context.Context) error {
+	c.current.Completed = true
+	if c.repo == nil {
+		return nil
+	}
+	if err := c.repo.SaveCheckpoint(ctx, c.current); err != nil {
+		return fmt.Errorf("failed to complete %s checkpoint: %w", c.current.Resource, err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"pokedex/internal/shared/pokeapi"
+	"pokedex/utils"
+)
+
+// DefaultPageSize is used when a Resource does not set PageSize.
+const DefaultPageSize = 100
+
+// Resource describes how the sync engine lists, fetches and stores one
+// PokeAPI resource. D is the detail model saved to the repository.
+type Resource[D any] struct {
+	Name     string // key for stats, checkpoints and dead-letters, e.g. "pokemon-type"
+	Endpoint string // PokeAPI list endpoint, e.g. "type" or "evolution-chain"
+	PageSize int
+
+	FetchDetail func(ctx context.Context, url string) (D, error)
+	Save        func(ctx context.Context, detail D) error
+
+	// LastSyncedAt enables --incremental; without it every item is fetched.
+	LastSyncedAt LastSyncedLookup
+
+	// ActiveNames and Reconcile enable tombstoning of documents that are
+	// no longer listed upstream (see Reconciler). Both must be set.
+	ActiveNames func(ctx context.Context) (map[int]string, error)
+	Reconcile   func(ctx context.Context, seenIDs []int) error
+}
+
+// Progress is reported after every processed page of the list.
+type Progress struct {
+	Resource  string
+	Processed int // list items handled so far, including skipped ones
+	Total     int // list size reported by PokeAPI
+	Stats     Stats
+}
+
+// Run pages through the resource's list endpoint, fetches the details of
+// every (stale) item with bounded concurrency and saves them. It takes care
+// of checkpoints, incremental filtering, re-queueing, dead-letters and
+// tombstoning, so a module only has to describe the resource.
+func Run[D any](ctx context.Context, client *pokeapi.Client, opts Options, res Resource[D]) (Stats, error) {
+	log.Printf("Starting full %s data synchronization...\n", res.Name)
+
+	stats := Stats{Resource: res.Name}
+	start := time.Now()
+	finish := func(err error) (Stats, error) {
+		stats.Duration = time.Since(start)
+		return stats, err
+	}
+
+	cp, err := StartCheckpoint(ctx, opts, res.Name)
+	if err != nil {
+		return finish(err)
+	}
+
+	// Catat ID yang terlihat di upstream untuk mendeteksi data yang dihapus atau diganti nama
+	var rc *Reconciler
+	if res.ActiveNames != nil && res.Reconcile != nil {
+		rc, err = StartReconcile(ctx, opts, cp, res.Name, res.ActiveNames)
+		if err != nil {
+			return finish(err)
+		}
+	}
+
+	limit := res.PageSize
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	offset := cp.Offset()
+
+	for {
+		listCtx, cancelList := context.WithTimeout(ctx, 30*time.Second)
+		page, err := client.FetchResourceList(listCtx, res.Endpoint, limit, offset)
+		cancelList()
+
+		if err != nil {
+			if retryAfter := pokeapi.RetryAfter(err); retryAfter > 0 && ctx.Err() == nil {
+				log.Printf("Rate limit hit during %s list fetch, retrying after %v...\n", res.Name, retryAfter)
+				time.Sleep(retryAfter)
+				continue
+			}
+			return finish(fmt.Errorf("failed to fetch %s list from PokeAPI: %w", res.Name, err))
+		}
+
+		if len(page.Results) == 0 {
+			break // No more data to fetch
+		}
+
+		if rc != nil {
+			for _, item := range page.Results {
+				rc.See(utils.ExtractIDFromURL(item.URL), item.Name)
+			}
+		}
+
+		items := page.Results
+		if opts.Incremental && res.LastSyncedAt != nil {
+			// Lewati item yang dokumennya masih segar (lebih muda dari TTL)
+			var skipped int
+			items, skipped, err = FilterStale(ctx, items, func(item pokeapi.NamedResource) string { return item.URL }, res.LastSyncedAt, opts.FreshnessTTL)
+			if err != nil {
+				return finish(err)
+			}
+			stats.Skipped += skipped
+		}
+
+		// Fetch details through the shared client; transient failures are re-queued
+		fetchDetails(ctx, items, opts.Concurrency, res.FetchDetail,
+			func(item pokeapi.NamedResource, detail D, err error) {
+				if err != nil {
+					stats.Failed++
+					log.Printf("Error fetching detail for %s %s: %v\n", res.Name, item.URL, err)
+					RecordFetchFailure(ctx, opts, res.Name, item.URL, err)
+					return
+				}
+				stats.Fetched++
+
+				// Save to Repository
+				if err := res.Save(ctx, detail); err != nil {
+					stats.Failed++
+					log.Printf("Failed to save %s %s to repository: %v\n", res.Name, item.URL, err)
+					RecordSaveFailure(ctx, opts, res.Name, item.URL, err)
+					return
+				}
+				stats.Saved++
+			})
+
+		reportProgress(opts, Progress{
+			Resource:  res.Name,
+			Processed: min(offset+len(page.Results), page.Count),
+			Total:     page.Count,
+			Stats:     stats,
+		})
+
+		// Jangan tandai batch sebagai selesai jika context habis di tengah batch
+		if ctx.Err() != nil {
+			return finish(fmt.Errorf("%s sync interrupted at offset %d: %w", res.Name, offset, ctx.Err()))
+		}
+
+		offset += limit
+		if err := cp.Advance(ctx, offset); err != nil {
+			log.Printf("Warning: %v\n", err)
+		}
+		if offset >= page.Count {
+			break
+		}
+	}
+
+	if rc != nil {
+		report, err := rc.Finish(ctx, res.Reconcile)
+		if err != nil {
+			log.Printf("Warning: %v\n", err)
+		}
+		stats.Tombstoned = len(report.Removed)
+		stats.Renamed = len(report.Renamed)
+	}
+
+	if err := cp.Complete(ctx); err != nil {
+		log.Printf("Warning: %v\n", err)
+	}
+
+	log.Printf("Full %s data synchronization completed. Total synced: %d\n", res.Name, stats.Saved)
+	return finish(nil)
+}
+
+// SyncOne fetches a single item of res by its PokeAPI URL and saves it.
+// It is used to replay dead-lettered items from the sync_failures collection.
+func SyncOne[D any](ctx context.Context, res Resource[D], url string) error {
+	detail, err := res.FetchDetail(ctx, url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s %s from PokeAPI: %w", res.Name, url, err)
+	}
+	return res.Save(ctx, detail)
+}
+
+func reportProgress(opts Options, progress Progress) {
+	if opts.OnProgress != nil {
+		opts.OnProgress(progress)
+		return
+	}
+	log.Printf("Batch processed. %s: %d/%d listed (saved: %d, failed: %d, skipped as fresh: %d)\n",
+		progress.Resource, progress.Processed, progress.Total, progress.Stats.Saved, progress.Stats.Failed, progress.Stats.Skipped)
+}
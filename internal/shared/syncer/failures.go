@@ -0,0 +1,38 @@
+package syncer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"pokedex/internal/shared/pokeapi"
+)
+
+// ErrorClassSave marks items whose detail was fetched but could not be stored.
+const ErrorClassSave = "save"
+
+// RecordFetchFailure dead-letters an item whose detail fetch failed.
+func RecordFetchFailure(ctx context.Context, opts Options, resource, url string, err error) {
+	recordFailure(ctx, opts, resource, url, pokeapi.ErrorClass(err), err)
+}
+
+// RecordSaveFailure dead-letters an item whose detail could not be saved.
+func RecordSaveFailure(ctx context.Context, opts Options, resource, url string, err error) {
+	recordFailure(ctx, opts, resource, url, ErrorClassSave, err)
+}
+
+func recordFailure(ctx context.Context, opts Options, resource, url, class string, err error) {
+	// Item yang gagal karena run dihentikan tidak hilang: checkpoint belum maju,
+	// jadi item tersebut akan diambil lagi saat resume.
+	if opts.Failures == nil || class == "canceled" {
+		return
+	}
+
+	// Tetap catat walaupun context sync sudah hampir habis
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if recordErr := opts.Failures.RecordFailure(recordCtx, resource, url, class, err.Error()); recordErr != nil {
+		log.Printf("Warning: %v\n", recordErr)
+	}
+}
@@ -0,0 +1,91 @@
+package syncer
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"pokedex/internal/shared/pokeapi"
+)
+
+// MaxRequeueRounds is how many extra passes fetchDetails makes over items whose
+// detail fetch failed with a retryable error after the client exhausted its own retries.
+const MaxRequeueRounds = 3
+
+// DefaultConcurrency bounds the detail fetches in flight when Options.Concurrency is not set.
+const DefaultConcurrency = 16
+
+// fetchDetails fetches the detail of every item through the shared PokeAPI
+// client, at most concurrency at a time, and calls handle once per item from
+// the calling goroutine. Items that fail with a retryable error (see
+// pokeapi.IsRetryable) are re-queued for up to MaxRequeueRounds further passes
+// instead of being dropped, so handle only ever sees a retryable error once
+// the item has run out of rounds.
+func fetchDetails[D any](
+	ctx context.Context,
+	items []pokeapi.NamedResource,
+	concurrency int,
+	fetch func(ctx context.Context, url string) (D, error),
+	handle func(item pokeapi.NamedResource, detail D, err error),
+) {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	pending := items
+	for round := 0; len(pending) > 0; round++ {
+		if round > 0 {
+			log.Printf("Re-queueing %d failed item(s) (round %d/%d)...\n", len(pending), round, MaxRequeueRounds)
+		}
+
+		type result struct {
+			Item   pokeapi.NamedResource
+			Detail D
+			Err    error
+		}
+
+		var wg sync.WaitGroup
+		resultsChan := make(chan result, len(pending))
+		slots := make(chan struct{}, concurrency)
+
+		// Enqueue each detail fetch through the shared client, keeping at most
+		// `concurrency` in flight, and close the channel once all have reported
+		go func(batch []pokeapi.NamedResource) {
+			for _, item := range batch {
+				slots <- struct{}{}
+				wg.Add(1)
+				go func(item pokeapi.NamedResource) {
+					defer wg.Done()
+					defer func() { <-slots }()
+					detail, err := fetch(ctx, item.URL)
+					resultsChan <- result{Item: item, Detail: detail, Err: err}
+				}(item)
+			}
+			wg.Wait()
+			close(resultsChan)
+		}(pending)
+
+		var requeue []pokeapi.NamedResource
+		var longestWait time.Duration
+		for res := range resultsChan {
+			if res.Err != nil && pokeapi.IsRetryable(res.Err) && round < MaxRequeueRounds && ctx.Err() == nil {
+				requeue = append(requeue, res.Item)
+				if wait := pokeapi.RetryAfter(res.Err); wait > longestWait {
+					longestWait = wait
+				}
+				continue
+			}
+			handle(res.Item, res.Detail, res.Err)
+		}
+
+		// Beri jeda sesuai Retry-After terpanjang sebelum mencoba lagi
+		if len(requeue) > 0 && longestWait > 0 {
+			select {
+			case <-time.After(longestWait):
+			case <-ctx.Done():
+			}
+		}
+		pending = requeue
+	}
+}
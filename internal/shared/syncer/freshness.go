@@ -0,0 +1,84 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pokedex/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LastSyncedLookup returns the stored last_synced_at (unix seconds) for each
+// of the given PokeAPI IDs. IDs that are not stored yet are simply absent.
+type LastSyncedLookup func(ctx context.Context, ids []int) (map[int]int64, error)
+
+// LastSyncedAt returns the last_synced_at timestamp of every document in coll
+// whose PokeAPI ID is in ids. Repositories use it to implement LastSyncedLookup.
+func LastSyncedAt(ctx context.Context, coll *mongo.Collection, ids []int) (map[int]int64, error) {
+	filter := bson.M{"id": bson.M{"$in": ids}}
+	findOptions := options.Find().SetProjection(bson.D{
+		{Key: "id", Value: 1},
+		{Key: "last_synced_at", Value: 1},
+	})
+
+	cursor, err := coll.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s sync times from DB: %w", coll.Name(), err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID           int   `bson:"id"`
+		LastSyncedAt int64 `bson:"last_synced_at"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode %s sync times from DB: %w", coll.Name(), err)
+	}
+
+	syncedAt := make(map[int]int64, len(docs))
+	for _, doc := range docs {
+		syncedAt[doc.ID] = doc.LastSyncedAt
+	}
+	return syncedAt, nil
+}
+
+// IsFresh reports whether a document synced at lastSyncedAt is still younger than ttl.
+func IsFresh(lastSyncedAt int64, ttl time.Duration, now time.Time) bool {
+	if lastSyncedAt <= 0 || ttl <= 0 {
+		return false
+	}
+	return now.Sub(time.Unix(lastSyncedAt, 0)) < ttl
+}
+
+// FilterStale drops list items whose stored document is younger than ttl,
+// so only stale or missing items go on to the (rate-limited) detail fetch.
+// It returns the items that still need fetching and how many were skipped.
+func FilterStale[T any](ctx context.Context, items []T, urlOf func(T) string, lookup LastSyncedLookup, ttl time.Duration) ([]T, int, error) {
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		if id := utils.ExtractIDFromURL(urlOf(item)); id > 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	syncedAt, err := lookup(ctx, ids)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to look up last sync times: %w", err)
+	}
+
+	now := time.Now()
+	stale := make([]T, 0, len(items))
+	for _, item := range items {
+		id := utils.ExtractIDFromURL(urlOf(item))
+		if IsFresh(syncedAt[id], ttl, now) {
+			continue
+		}
+		stale = append(stale, item)
+	}
+
+	return stale, len(items) - len(stale), nil
+}
@@ -0,0 +1,61 @@
+package syncer
+
+import (
+	"flag"
+	"time"
+
+	"pokedex/config"
+	"pokedex/internal/shared/checkpoint"
+	"pokedex/internal/shared/syncfailure"
+	"pokedex/internal/shared/tombstone"
+)
+
+// Options controls how a SyncAll* run behaves.
+type Options struct {
+	// Restart ignores any saved checkpoint and starts the resource from offset 0.
+	Restart bool
+
+	// Incremental skips the detail fetch for items whose stored document
+	// is younger than FreshnessTTL, refreshing only stale or missing items.
+	Incremental  bool
+	FreshnessTTL time.Duration
+
+	// Concurrency bounds the detail fetches a sync keeps in flight.
+	Concurrency int
+
+	// OnProgress is called after every processed page. When nil, progress is logged.
+	OnProgress func(Progress)
+
+	// Checkpoints persists progress after every completed batch.
+	// When nil, the sync keeps its offset in memory only.
+	Checkpoints checkpoint.CheckpointRepository
+
+	// Failures dead-letters items that could not be fetched or saved,
+	// so cmd/sync-retry can replay them. When nil, failures are only logged.
+	Failures syncfailure.FailureRepository
+
+	// Reports stores the removals and renames found by each full run.
+	// When nil, they are only logged.
+	Reports tombstone.ReportRepository
+}
+
+// BindFlags registers the command-line flags shared by every sync command.
+// Repositories are not flags; set them on the returned Options after connecting to MongoDB.
+func BindFlags(fs *flag.FlagSet) *Options {
+	opts := &Options{}
+	fs.BoolVar(&opts.Restart, "restart", false, "ignore saved checkpoints and sync from the beginning")
+	fs.BoolVar(&opts.Incremental, "incremental", false, "only fetch items that are missing or older than --ttl")
+	fs.DurationVar(&opts.FreshnessTTL, "ttl", 0, "freshness TTL for --incremental (default SYNC_FRESHNESS_TTL_HOURS)")
+	fs.IntVar(&opts.Concurrency, "concurrency", 0, "detail fetches in flight per resource (default SYNC_CONCURRENCY)")
+	return opts
+}
+
+// ApplyConfig fills in defaults that were not given on the command line.
+func (o *Options) ApplyConfig(cfg *config.Config) {
+	if o.FreshnessTTL <= 0 {
+		o.FreshnessTTL = time.Duration(cfg.SyncFreshnessTTLHours) * time.Hour
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = cfg.SyncConcurrency
+	}
+}
@@ -0,0 +1,160 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"text/tabwriter"
+	"time"
+)
+
+// Stage is a single named sync job in the dependency graph.
+type Stage struct {
+	Name      string
+	DependsOn []string
+	Run       func(ctx context.Context) (Stats, error)
+}
+
+// StageResult records what happened to a stage during RunStages.
+type StageResult struct {
+	Name    string
+	Stats   Stats
+	Err     error
+	Skipped bool // true when a prerequisite failed, so the stage never ran
+}
+
+// RunStages runs every stage as soon as all of its prerequisites have finished
+// successfully. Independent stages run in parallel. If a stage fails, every
+// stage depending on it (directly or transitively) is skipped.
+// Results are returned in the same order as the input stages.
+func RunStages(ctx context.Context, stages []Stage) ([]StageResult, error) {
+	if err := validateStages(stages); err != nil {
+		return nil, err
+	}
+
+	results := make([]StageResult, len(stages))
+	done := make(map[string]chan struct{}, len(stages))
+	indexByName := make(map[string]int, len(stages))
+	for i, stage := range stages {
+		done[stage.Name] = make(chan struct{})
+		indexByName[stage.Name] = i
+	}
+
+	for i, stage := range stages {
+		go func(i int, stage Stage) {
+			defer close(done[stage.Name])
+			results[i].Name = stage.Name
+
+			// Tunggu semua prerequisite selesai
+			for _, dep := range stage.DependsOn {
+				<-done[dep]
+				depResult := results[indexByName[dep]]
+				if depResult.Err != nil || depResult.Skipped {
+					log.Printf("Skipping stage %s: prerequisite %s did not complete.\n", stage.Name, dep)
+					results[i].Skipped = true
+					return
+				}
+			}
+
+			if ctx.Err() != nil {
+				results[i].Err = ctx.Err()
+				return
+			}
+
+			log.Printf("Starting stage %s...\n", stage.Name)
+			start := time.Now()
+			stats, err := stage.Run(ctx)
+			if stats.Resource == "" {
+				stats.Resource = stage.Name
+			}
+			if stats.Duration == 0 {
+				stats.Duration = time.Since(start)
+			}
+			results[i].Stats = stats
+			results[i].Err = err
+
+			if err != nil {
+				log.Printf("Stage %s failed: %v\n", stage.Name, err)
+			} else {
+				log.Printf("Stage %s finished. %s\n", stage.Name, stats)
+			}
+		}(i, stage)
+	}
+
+	for _, stage := range stages {
+		<-done[stage.Name]
+	}
+
+	return results, nil
+}
+
+// validateStages rejects duplicate names, unknown prerequisites and cycles,
+// any of which would make RunStages block forever.
+func validateStages(stages []Stage) error {
+	deps := make(map[string][]string, len(stages))
+	for _, stage := range stages {
+		if _, exists := deps[stage.Name]; exists {
+			return fmt.Errorf("duplicate sync stage %q", stage.Name)
+		}
+		deps[stage.Name] = stage.DependsOn
+	}
+
+	for name, stageDeps := range deps {
+		for _, dep := range stageDeps {
+			if _, exists := deps[dep]; !exists {
+				return fmt.Errorf("sync stage %q depends on unknown stage %q", name, dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(stages))
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("sync stage dependency cycle detected at %q", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dep := range deps[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+
+	for _, stage := range stages {
+		if err := visit(stage.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintSummary writes a per-stage table of fetched, saved, failed, skipped and tombstoned counts.
+func PrintSummary(w io.Writer, results []StageResult) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STAGE\tSTATUS\tFETCHED\tSAVED\tFAILED\tSKIPPED\tTOMBSTONED\tDURATION")
+	for _, res := range results {
+		status := "ok"
+		switch {
+		case res.Skipped:
+			status = "skipped"
+		case res.Err != nil:
+			status = "failed"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%s\n",
+			res.Name, status, res.Stats.Fetched, res.Stats.Saved, res.Stats.Failed, res.Stats.Skipped, res.Stats.Tombstoned, res.Stats.Duration.Round(time.Second))
+	}
+	tw.Flush()
+}
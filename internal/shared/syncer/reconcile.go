@@ -0,0 +1,101 @@
+package syncer
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"pokedex/internal/shared/tombstone"
+)
+
+// Reconciler records which IDs a full sync pass saw upstream, so documents
+// that disappeared can be tombstoned and renames reported once the pass completes.
+type Reconciler struct {
+	resource string
+	runID    string
+	reports  tombstone.ReportRepository
+	enabled  bool
+	before   map[int]string
+	seen     map[int]string
+}
+
+// StartReconcile snapshots the names of the active documents before the run saves anything.
+// A resumed run did not see the batches before its checkpoint, so it never reconciles.
+func StartReconcile(ctx context.Context, opts Options, cp *Checkpointer, resource string, activeNames func(ctx context.Context) (map[int]string, error)) (*Reconciler, error) {
+	rc := &Reconciler{
+		resource: resource,
+		runID:    cp.RunID(),
+		reports:  opts.Reports,
+		seen:     make(map[int]string),
+	}
+	if cp.Resumed() || cp.Offset() != 0 {
+		log.Printf("Skipping %s deletion detection: run was resumed from a checkpoint.\n", resource)
+		return rc, nil
+	}
+
+	before, err := activeNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rc.before = before
+	rc.enabled = true
+	return rc, nil
+}
+
+// See marks id as present upstream under name.
+func (r *Reconciler) See(id int, name string) {
+	if id == 0 {
+		return
+	}
+	r.seen[id] = name
+}
+
+// Finish tombstones everything that was not seen and returns the run's report.
+// Call it only after the pass went through every page of the list.
+func (r *Reconciler) Finish(ctx context.Context, reconcile func(ctx context.Context, seenIDs []int) error) (tombstone.Report, error) {
+	report := tombstone.Report{
+		Resource:  r.resource,
+		RunID:     r.runID,
+		Removed:   []tombstone.Entry{},
+		Renamed:   []tombstone.Entry{},
+		CreatedAt: time.Now().Unix(),
+	}
+	// Daftar kosong hampir pasti berarti upstream bermasalah, bukan semua data dihapus
+	if !r.enabled || len(r.seen) == 0 {
+		return report, nil
+	}
+
+	seenIDs := make([]int, 0, len(r.seen))
+	for id := range r.seen {
+		seenIDs = append(seenIDs, id)
+	}
+	if err := reconcile(ctx, seenIDs); err != nil {
+		return report, err
+	}
+
+	for id, oldName := range r.before {
+		newName, ok := r.seen[id]
+		if !ok {
+			report.Removed = append(report.Removed, tombstone.Entry{ID: id, Name: oldName})
+		} else if newName != oldName {
+			report.Renamed = append(report.Renamed, tombstone.Entry{ID: id, Name: newName, PreviousName: oldName})
+		}
+	}
+	sort.Slice(report.Removed, func(i, j int) bool { return report.Removed[i].ID < report.Removed[j].ID })
+	sort.Slice(report.Renamed, func(i, j int) bool { return report.Renamed[i].ID < report.Renamed[j].ID })
+
+	for _, entry := range report.Removed {
+		log.Printf("%s %d (%s) is no longer listed upstream; tombstoned.\n", r.resource, entry.ID, entry.Name)
+	}
+	for _, entry := range report.Renamed {
+		log.Printf("%s %d renamed upstream: %s -> %s\n", r.resource, entry.ID, entry.PreviousName, entry.Name)
+	}
+
+	if r.reports != nil {
+		if err := r.reports.SaveReport(ctx, report); err != nil {
+			log.Printf("Warning: %v\n", err)
+		}
+	}
+	return report, nil
+}
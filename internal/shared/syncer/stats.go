@@ -0,0 +1,23 @@
+package syncer
+
+import (
+	"fmt"
+	"time"
+)
+
+// Stats summarises the outcome of a single SyncAll* run.
+type Stats struct {
+	Resource   string
+	Fetched    int // detail payloads successfully fetched from PokeAPI
+	Saved      int // documents successfully written to MongoDB
+	Failed     int // items lost to fetch or save errors
+	Skipped    int // items left alone because their stored document is still fresh
+	Tombstoned int // documents no longer listed upstream, now hidden from the API
+	Renamed    int // documents listed upstream under a new name
+	Duration   time.Duration
+}
+
+func (s Stats) String() string {
+	return fmt.Sprintf("%s: fetched=%d saved=%d failed=%d skipped=%d tombstoned=%d renamed=%d duration=%s",
+		s.Resource, s.Fetched, s.Saved, s.Failed, s.Skipped, s.Tombstoned, s.Renamed, s.Duration.Round(time.Second))
+}
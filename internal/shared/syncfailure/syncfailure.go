@@ -0,0 +1,102 @@
+package syncfailure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pokedex/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const failureCollectionName = "sync_failures"
+
+// Failure is a dead-lettered sync item: a detail fetch or save that did not
+// succeed even after the client's retries and the sync's re-queue rounds.
+type Failure struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	Resource      string             `bson:"resource"`
+	URL           string             `bson:"url"`
+	ErrorClass    string             `bson:"error_class"`
+	Error         string             `bson:"error"`
+	Attempts      int                `bson:"attempts"`
+	FirstFailedAt int64              `bson:"first_failed_at"`
+	LastFailedAt  int64              `bson:"last_failed_at"`
+}
+
+// FailureRepository defines the interface for persisting failed sync items.
+type FailureRepository interface {
+	RecordFailure(ctx context.Context, resource, url, errorClass, errMessage string) error
+	ListFailures(ctx context.Context, resource string) ([]Failure, error)
+	DeleteFailure(ctx context.Context, resource, url string) error
+}
+
+// MongoFailureRepository implements the FailureRepository interface for MongoDB.
+type MongoFailureRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoFailureRepository creates a new MongoDB repository for failed sync items.
+func NewMongoFailureRepository() *MongoFailureRepository {
+	return &MongoFailureRepository{
+		collection: database.MongoDatabase.Collection(failureCollectionName),
+	}
+}
+
+// RecordFailure upserts the failure for (resource, url) and bumps its attempt count.
+func (r *MongoFailureRepository) RecordFailure(ctx context.Context, resource, url, errorClass, errMessage string) error {
+	now := time.Now().Unix()
+
+	filter := bson.M{"resource": resource, "url": url}
+	update := bson.M{
+		"$set": bson.M{
+			"error_class":    errorClass,
+			"error":          errMessage,
+			"last_failed_at": now,
+		},
+		"$inc":         bson.M{"attempts": 1},
+		"$setOnInsert": bson.M{"first_failed_at": now},
+	}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to record sync failure for %s %s: %w", resource, url, err)
+	}
+	return nil
+}
+
+// ListFailures returns every recorded failure, oldest first.
+// An empty resource returns failures of all resources.
+func (r *MongoFailureRepository) ListFailures(ctx context.Context, resource string) ([]Failure, error) {
+	filter := bson.M{}
+	if resource != "" {
+		filter["resource"] = resource
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "first_failed_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sync failures from DB: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var failures []Failure
+	if err = cursor.All(ctx, &failures); err != nil {
+		return nil, fmt.Errorf("failed to decode sync failures from DB: %w", err)
+	}
+	return failures, nil
+}
+
+// DeleteFailure clears a failure once the item has been synced successfully.
+func (r *MongoFailureRepository) DeleteFailure(ctx context.Context, resource, url string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"resource": resource, "url": url})
+	if err != nil {
+		return fmt.Errorf("failed to delete sync failure for %s %s: %w", resource, url, err)
+	}
+	return nil
+}
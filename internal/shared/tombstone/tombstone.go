@@ -0,0 +1,122 @@
+package tombstone
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pokedex/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Field is set (to a unix timestamp) on documents that a full sync no longer saw upstream.
+const Field = "tombstoned_at"
+
+const reportCollectionName = "sync_reports"
+
+// Active adds the "not tombstoned" condition to filter, so tombstoned
+// documents never reach API responses. The given filter is modified and returned.
+func Active(filter bson.M) bson.M {
+	filter[Field] = bson.M{"$exists": false}
+	return filter
+}
+
+// ActiveNames returns the id -> name map of every document in coll that is not tombstoned.
+func ActiveNames(ctx context.Context, coll *mongo.Collection) (map[int]string, error) {
+	findOptions := options.Find().SetProjection(bson.D{
+		{Key: "id", Value: 1},
+		{Key: "name", Value: 1},
+	})
+
+	cursor, err := coll.Find(ctx, Active(bson.M{}), findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s names from DB: %w", coll.Name(), err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID   int    `bson:"id"`
+		Name string `bson:"name"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode %s names from DB: %w", coll.Name(), err)
+	}
+
+	names := make(map[int]string, len(docs))
+	for _, doc := range docs {
+		names[doc.ID] = doc.Name
+	}
+	return names, nil
+}
+
+// Reconcile tombstones every document in coll whose id is not in seenIDs and
+// restores any tombstoned document that was seen again.
+func Reconcile(ctx context.Context, coll *mongo.Collection, seenIDs []int) error {
+	_, err := coll.UpdateMany(ctx,
+		Active(bson.M{"id": bson.M{"$nin": seenIDs}}),
+		bson.M{"$set": bson.M{Field: time.Now().Unix()}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to tombstone unseen %s: %w", coll.Name(), err)
+	}
+
+	_, err = coll.UpdateMany(ctx,
+		bson.M{"id": bson.M{"$in": seenIDs}, Field: bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{Field: ""}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore tombstoned %s: %w", coll.Name(), err)
+	}
+	return nil
+}
+
+// Entry is a single document affected by a sync run.
+type Entry struct {
+	ID           int    `bson:"id" json:"id"`
+	Name         string `bson:"name" json:"name"`
+	PreviousName string `bson:"previous_name,omitempty" json:"previous_name,omitempty"`
+}
+
+// Report lists what a full sync run removed (tombstoned) and renamed.
+type Report struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Resource  string             `bson:"resource"`
+	RunID     string             `bson:"run_id"`
+	Removed   []Entry            `bson:"removed"`
+	Renamed   []Entry            `bson:"renamed"`
+	CreatedAt int64              `bson:"created_at"`
+}
+
+// ReportRepository defines the interface for persisting sync reports.
+type ReportRepository interface {
+	SaveReport(ctx context.Context, report Report) error
+}
+
+// MongoReportRepository implements the ReportRepository interface for MongoDB.
+type MongoReportRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoReportRepository creates a new MongoDB repository for sync reports.
+func NewMongoReportRepository() *MongoReportRepository {
+	return &MongoReportRepository{
+		collection: database.MongoDatabase.Collection(reportCollectionName),
+	}
+}
+
+// SaveReport upserts the report of a run, keyed by resource and run ID.
+func (r *MongoReportRepository) SaveReport(ctx context.Context, report Report) error {
+	filter := bson.M{"resource": report.Resource, "run_id": report.RunID}
+	update := bson.M{"$set": report}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to save %s sync report: %w", report.Resource, err)
+	}
+	return nil
+}
@@ -16,9 +16,39 @@ import (
 	ability_handler "pokedex/internal/ability/handler"
 	ability_repo "pokedex/internal/ability/repository"
 	ability_service "pokedex/internal/ability/service"
+	berry_handler "pokedex/internal/berry/handler"
+	berry_repo "pokedex/internal/berry/repository"
+	berry_service "pokedex/internal/berry/service"
+	egg_group_handler "pokedex/internal/egg-group/handler"
+	egg_group_repo "pokedex/internal/egg-group/repository"
+	egg_group_service "pokedex/internal/egg-group/service"
+	encounter_handler "pokedex/internal/encounter/handler"
+	encounter_repo "pokedex/internal/encounter/repository"
+	encounter_service "pokedex/internal/encounter/service"
 	evolution_handler "pokedex/internal/evolution/handler"
 	evolution_repo "pokedex/internal/evolution/repository"
 	evolution_service "pokedex/internal/evolution/service"
+	generation_handler "pokedex/internal/generation/handler"
+	generation_repo "pokedex/internal/generation/repository"
+	generation_service "pokedex/internal/generation/service"
+	growth_rate_handler "pokedex/internal/growth-rate/handler"
+	growth_rate_repo "pokedex/internal/growth-rate/repository"
+	growth_rate_service "pokedex/internal/growth-rate/service"
+	item_handler "pokedex/internal/item/handler"
+	item_repo "pokedex/internal/item/repository"
+	item_service "pokedex/internal/item/service"
+	move_handler "pokedex/internal/move/handler"
+	move_repo "pokedex/internal/move/repository"
+	move_service "pokedex/internal/move/service"
+	nature_handler "pokedex/internal/nature/handler"
+	nature_repo "pokedex/internal/nature/repository"
+	nature_service "pokedex/internal/nature/service"
+	pokedex_handler "pokedex/internal/pokedex/handler"
+	pokedex_repo "pokedex/internal/pokedex/repository"
+	pokedex_service "pokedex/internal/pokedex/service"
+	pokemon_form_handler "pokedex/internal/pokemon-form/handler"
+	pokemon_form_repo "pokedex/internal/pokemon-form/repository"
+	pokemon_form_service "pokedex/internal/pokemon-form/service"
 	pokemon_species_handler "pokedex/internal/pokemon-species/handler"
 	pokemon_species_repo "pokedex/internal/pokemon-species/repository"
 	pokemon_species_service "pokedex/internal/pokemon-species/service"
@@ -28,6 +58,7 @@ import (
 	pokemon_handler "pokedex/internal/pokemon/handler"
 	pokemon_repo "pokedex/internal/pokemon/repository"
 	pokemon_service "pokedex/internal/pokemon/service"
+	"pokedex/internal/shared/spritemirror"
 )
 
 func main() {
@@ -38,13 +69,18 @@ func main() {
 	database.ConnectDB(cfg)
 	defer database.DisconnectDB()
 
+	// Serve and link the local sprite mirror when SPRITE_MIRROR_DIR is set
+	spritemirror.Configure(cfg)
+
 	// Initialize shared PokeAPI client (handles rate limiting for all modules)
 	pokeAPIClient := pokeapi.NewClient(cfg)
 	defer pokeAPIClient.CloseClient()
 
 	// --- Initialize Pokemon Module Components ---
+	itemRepo := item_repo.NewMongoItemRepository()
+
 	evolutionRepo := evolution_repo.NewMongoEvolutionRepository()
-	evolutionService := evolution_service.NewEvolutionService(evolutionRepo, pokeAPIClient)
+	evolutionService := evolution_service.NewEvolutionService(evolutionRepo, pokeAPIClient, itemRepo)
 	evolutionHandler := evolution_handler.NewEvolutionHandler(evolutionService)
 
 	pokemonRepo := pokemon_repo.NewMongoPokemonRepository()
@@ -63,6 +99,45 @@ func main() {
 	pokemonTypeService := pokemon_type_service.NewPokemonTypeService(pokemonTypeRepo, pokeAPIClient)
 	pokemonTypeHandler := pokemon_type_handler.NewPokemonTypeHandler(pokemonTypeService)
 
+	moveRepo := move_repo.NewMongoMoveRepository()
+	moveService := move_service.NewMoveService(moveRepo, pokeAPIClient)
+	moveHandler := move_handler.NewMoveHandler(moveService)
+
+	itemService := item_service.NewItemService(itemRepo, pokeAPIClient)
+	itemHandler := item_handler.NewItemHandler(itemService)
+
+	encounterRepo := encounter_repo.NewMongoEncounterRepository()
+	encounterService := encounter_service.NewEncounterService(encounterRepo, pokeAPIClient)
+	encounterHandler := encounter_handler.NewEncounterHandler(encounterService)
+
+	natureRepo := nature_repo.NewMongoNatureRepository()
+	natureService := nature_service.NewNatureService(natureRepo, pokeAPIClient)
+	natureHandler := nature_handler.NewNatureHandler(natureService)
+
+	generationRepo := generation_repo.NewMongoGenerationRepository()
+	generationService := generation_service.NewGenerationService(generationRepo, pokeAPIClient)
+	generationHandler := generation_handler.NewGenerationHandler(generationService)
+
+	pokedexRepo := pokedex_repo.NewMongoPokedexRepository()
+	pokedexService := pokedex_service.NewPokedexService(pokedexRepo, pokeAPIClient)
+	pokedexHandler := pokedex_handler.NewPokedexHandler(pokedexService)
+
+	eggGroupRepo := egg_group_repo.NewMongoEggGroupRepository()
+	eggGroupService := egg_group_service.NewEggGroupService(eggGroupRepo, pokeAPIClient)
+	eggGroupHandler := egg_group_handler.NewEggGroupHandler(eggGroupService)
+
+	berryRepo := berry_repo.NewMongoBerryRepository()
+	berryService := berry_service.NewBerryService(berryRepo, pokeAPIClient)
+	berryHandler := berry_handler.NewBerryHandler(berryService)
+
+	growthRateRepo := growth_rate_repo.NewMongoGrowthRateRepository()
+	growthRateService := growth_rate_service.NewGrowthRateService(growthRateRepo, pokeAPIClient)
+	growthRateHandler := growth_rate_handler.NewGrowthRateHandler(growthRateService)
+
+	pokemonFormRepo := pokemon_form_repo.NewMongoPokemonFormRepository()
+	pokemonFormService := pokemon_form_service.NewPokemonFormService(pokemonFormRepo, pokeAPIClient)
+	pokemonFormHandler := pokemon_form_handler.NewPokemonFormHandler(pokemonFormService)
+
 	// --- End Pokemon Module Components ---
 
 	// Initialize Gin router
@@ -72,7 +147,7 @@ func main() {
 	routerEngine.Use(gin.Recovery()) // Tambahkan recovery
 
 	// Setup API routes for all modules
-	router.InitAPIRoutes(routerEngine, pokemonHandler, abilityHandler, pokemonSpeciesHandler, evolutionHandler, pokemonTypeHandler)
+	router.InitAPIRoutes(routerEngine, pokemonHandler, abilityHandler, pokemonSpeciesHandler, evolutionHandler, pokemonTypeHandler, moveHandler, itemHandler, encounterHandler, natureHandler, generationHandler, pokedexHandler, eggGroupHandler, berryHandler, growthRateHandler, pokemonFormHandler)
 
 	// Start Gin server
 	serverPort := ":" + cfg.Port
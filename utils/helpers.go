@@ -3,6 +3,8 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"unicode"
 )
 
@@ -26,3 +28,54 @@ func CapitalizeFirstLetter(s string) string {
 
 	return string(runes)
 }
+
+// ExtractIDFromURL returns the numeric ID at the end of a PokeAPI resource URL,
+// e.g. https://pokeapi.co/api/v2/pokemon/25/ -> 25. It returns 0 when there is none.
+func ExtractIDFromURL(url string) int {
+	trimmed := strings.TrimRight(url, "/")
+	lastSlash := strings.LastIndex(trimmed, "/")
+
+	id, err := strconv.Atoi(trimmed[lastSlash+1:])
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// NormalizeFlavorText cleans up a pokedex text as printed by the games. The text
+// keeps the in-game line breaks (\n) and page breaks (\f); these become single
+// spaces, and words hyphenated across a line break are joined again.
+func NormalizeFlavorText(text string) string {
+	text = strings.ReplaceAll(text, "\u00ad\n", "") // Soft hyphen di akhir baris
+	text = strings.ReplaceAll(text, "\u00ad", "")
+	text = strings.ReplaceAll(text, "-\n", "-")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// FindEnglish returns the first English entry of entries.
+func FindEnglish[T LocalizedEntry](entries []T) (T, bool) {
+	for _, entry := range entries {
+		if entry.LanguageName() == "en" {
+			return entry, true
+		}
+	}
+	var none T
+	return none, false
+}
+
+// EnglishText returns the text of the first English entry, or "" when there is none.
+func EnglishText[T LocalizedEntry](entries []T) string {
+	entry, _ := FindEnglish(entries)
+	return entry.LocalizedText()
+}
+
+// LastEnglishText returns the text of the last English entry, or "" when there is
+// none. PokeAPI lists per-version texts from oldest to newest.
+func LastEnglishText[T LocalizedEntry](entries []T) string {
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].LanguageName() == "en" {
+			return entries[i].LocalizedText()
+		}
+	}
+	return ""
+}
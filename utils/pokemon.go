@@ -91,27 +91,45 @@ func CalcEggCycles(eggCycles int) int {
 }
 
 func CalcMinStat(baseStat int, typeStat string) int {
-	var minStat = 0
+	return calcLevel100Stat(baseStat, typeStat, 0, 90)
+}
 
-	if typeStat == "hp" {
-		minStat = baseStat*2 + 110
-	} else {
-		minStat = int(float64(baseStat*2+5) * 0.9)
+func CalcMaxStat(baseStat int, typeStat string) int {
+	return calcLevel100Stat(baseStat, typeStat, 94, 110)
+}
+
+// NatureModifier returns the nature multiplier for a stat in percent: 110 when
+// the nature raises it, 90 when it lowers it and 100 otherwise (HP is never affected).
+func NatureModifier(typeStat, increasedStat, decreasedStat string) int {
+	if typeStat == "hp" || increasedStat == decreasedStat {
+		return 100
 	}
+	switch typeStat {
+	case increasedStat:
+		return 110
+	case decreasedStat:
+		return 90
+	}
+	return 100
+}
 
-	return minStat
+// CalcMinStatWithNature is CalcMinStat for one specific nature (0 IV, 0 EV, level 100).
+func CalcMinStatWithNature(baseStat int, typeStat string, natureModifier int) int {
+	return calcLevel100Stat(baseStat, typeStat, 0, natureModifier)
 }
 
-func CalcMaxStat(baseStat int, typeStat string) int {
-	var maxStat = 0
+// CalcMaxStatWithNature is CalcMaxStat for one specific nature (31 IV, 252 EV, level 100).
+func CalcMaxStatWithNature(baseStat int, typeStat string, natureModifier int) int {
+	return calcLevel100Stat(baseStat, typeStat, 94, natureModifier)
+}
 
+// calcLevel100Stat computes a stat at level 100. spread is IV + EV/4 (0 for the
+// minimum, 31 + 252/4 = 94 for the maximum) and natureModifier is in percent.
+func calcLevel100Stat(baseStat int, typeStat string, spread, natureModifier int) int {
 	if typeStat == "hp" {
-		maxStat = baseStat*2 + 204
-	} else {
-		maxStat = int(float64(baseStat*2+99) * 1.1)
+		return baseStat*2 + spread + 110
 	}
-
-	return maxStat
+	return (baseStat*2 + spread + 5) * natureModifier / 100
 }
 
 func GetThumbnailPokemon(pokemon_id int) string {
@@ -1,5 +1,11 @@
 package utils
 
+// LocalizedEntry is a PokeAPI entry in one language, e.g. a name, a description or an effect.
+type LocalizedEntry interface {
+	LanguageName() string
+	LocalizedText() string
+}
+
 type GenderDistributionResult struct {
 	Female string `json:"female"` // Contoh: "50.0%"
 	Male   string `json:"male"`   // Contoh: "50.0%"